@@ -1,9 +1,27 @@
 package models
 
+import "database/sql"
+
 //go:generate modelgen -file $GOFILE
 
 // @querybuilder
 type User struct {
-	ID   int64
+	ID       int64 `qb:"pk,autoincrement"`
+	Name     string
+	Email    string `db:"email_address"`
+	Password string `db:"-"`
+	Nickname *string
+	Age      sql.NullInt64
+}
+
+// @querybuilder pk=Code
+type Country struct {
+	Code string
 	Name string
 }
+
+// @querybuilder
+type UserRole struct {
+	UserID int64 `qb:"pk"`
+	RoleID int64 `qb:"pk"`
+}
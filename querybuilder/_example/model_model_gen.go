@@ -7,44 +7,141 @@ import (
     "strings"
     "database/sql"
 	"context"
+	"iter"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
 )
 
 
+
 type UserQueryBuilder interface {
 	WhereIDIs(int64) UserQueryBuilder
-	WhereID(operator string, rhs int64) UserQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) UserQueryBuilder
+	WhereIDIn(...int64) UserQueryBuilder
+	WhereIDNotIn(...int64) UserQueryBuilder
 
-	// WhereIDGT(int64) UserQueryBuilder
-	// WhereIDGE(int64) UserQueryBuilder
-	// WhereIDLT(int64) UserQueryBuilder
-	// WhereIDLE(int64) UserQueryBuilder
+	WhereIDGT(int64) UserQueryBuilder
+	WhereIDGE(int64) UserQueryBuilder
+	WhereIDLT(int64) UserQueryBuilder
+	WhereIDLE(int64) UserQueryBuilder
 
 	WhereNameIs(string) UserQueryBuilder
-	WhereName(operator string, rhs string) UserQueryBuilder
+	WhereName(operator qb.Operator, rhs string) UserQueryBuilder
+	WhereNameIn(...string) UserQueryBuilder
+	WhereNameNotIn(...string) UserQueryBuilder
+
+	WhereNameLike(pattern string) UserQueryBuilder
+	WhereNameILike(pattern string) UserQueryBuilder
+	WhereNameStartsWith(prefix string) UserQueryBuilder
+	WhereNameContains(substring string) UserQueryBuilder
+
+	WhereEmailIs(string) UserQueryBuilder
+	WhereEmail(operator qb.Operator, rhs string) UserQueryBuilder
+	WhereEmailIn(...string) UserQueryBuilder
+	WhereEmailNotIn(...string) UserQueryBuilder
+
+	WhereEmailLike(pattern string) UserQueryBuilder
+	WhereEmailILike(pattern string) UserQueryBuilder
+	WhereEmailStartsWith(prefix string) UserQueryBuilder
+	WhereEmailContains(substring string) UserQueryBuilder
+
+	WhereNicknameIs(*string) UserQueryBuilder
+	WhereNickname(operator qb.Operator, rhs *string) UserQueryBuilder
+	WhereNicknameIn(...*string) UserQueryBuilder
+	WhereNicknameNotIn(...*string) UserQueryBuilder
+
+	WhereNicknameLike(pattern string) UserQueryBuilder
+	WhereNicknameILike(pattern string) UserQueryBuilder
+	WhereNicknameStartsWith(prefix string) UserQueryBuilder
+	WhereNicknameContains(substring string) UserQueryBuilder
+
+	WhereNicknameIsNull() UserQueryBuilder
+	WhereNicknameIsNotNull() UserQueryBuilder
+
+	WhereAgeIs(sql.NullInt64) UserQueryBuilder
+	WhereAge(operator qb.Operator, rhs sql.NullInt64) UserQueryBuilder
+	WhereAgeIn(...sql.NullInt64) UserQueryBuilder
+	WhereAgeNotIn(...sql.NullInt64) UserQueryBuilder
+
+	WhereAgeIsNull() UserQueryBuilder
+	WhereAgeIsNotNull() UserQueryBuilder
+
+	WhereRaw(fragment string, args ...any) UserQueryBuilder
+
+	Or(func(b UserQueryBuilder)) UserQueryBuilder
+	And(func(b UserQueryBuilder)) UserQueryBuilder
 
 	OrderByAsc(column UserColumn) UserQueryBuilder
 	OrderByDesc(column UserColumn) UserQueryBuilder
+	OrderByRaw(fragment string, args ...any) UserQueryBuilder
+
+	Select(columns ...UserColumn) UserQueryBuilder
+	SelectRaw(fragment string, args ...any) UserQueryBuilder
+
+	GroupBy(columns ...UserColumn) UserQueryBuilder
+	Having(fragment string, args ...any) UserQueryBuilder
+	HavingCount(operator qb.Operator, count int64) UserQueryBuilder
+
+	After(cursor string) UserQueryBuilder
+	Before(cursor string) UserQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (UserCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserPage, error)
 
 	Limit(int) UserQueryBuilder
 	Offset(int) UserQueryBuilder
 
 	getPlaceholder() string
 
-	First(db *sql.DB) (User, error)
-	Last(db *sql.DB) (User, error)
+	First(ctx context.Context, db qb.Executor) (User, error)
+	Last(ctx context.Context, db qb.Executor) (User, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (User, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
 
 	SetID(int64) UserQueryBuilder
 
 	SetName(string) UserQueryBuilder
 
-	Add(ctx context.Context, record *User, db *sql.DB) error
+	SetEmail(string) UserQueryBuilder
+
+	SetNickname(*string) UserQueryBuilder
+	SetNicknameNull() UserQueryBuilder
+
+	SetAge(sql.NullInt64) UserQueryBuilder
+	SetAgeNull() UserQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *User) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*User) error
+
+	OnConflictUpdate(columns ...UserColumn) UserQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *User, conflictColumns ...UserColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
 
-	Update(db *sql.DB) (sql.Result, error)
+	Fetch(ctx context.Context, db qb.Executor) ([]User, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]User, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[User, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]User) error) error
 
-	Delete(db *sql.DB) (sql.Result, error)
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]UserGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckName(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckEmail(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckNickname(ctx context.Context, db qb.Executor) ([]*string, error)
+	PluckAge(ctx context.Context, db qb.Executor) ([]sql.NullInt64, error)
 
-	Fetch(db *sql.DB) ([]User, error)
-	FindAll(db *sql.DB) ([]User, error)
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
 
 	SQL() (string, error)
 
@@ -54,35 +151,61 @@ type UserQueryBuilder interface {
 type _dont_use_user_query_builder struct {
 	mode string
 
-	where struct {
+	where []qb.Predicate
+
+	set struct {
 		ID struct {
 			argument interface{}
-			operator string
+			literal  string
+			isSet    bool
 		}
 
 		Name struct {
 			argument interface{}
-			operator string
+			literal  string
+			isSet    bool
 		}
-	}
 
-	set struct {
-		ID string
+		Email struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Nickname struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
 
-		Name string
+		Age struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
 	}
 
-	orderBy []string
-	groupBy string
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []UserColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []UserColumn
 
-	projected []string
+	selected  []UserColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []User) error
 
 	limit  int
 	offset int
 
-	whereArgs  []interface{}
-	setArgs    []interface{}
-	valuesArgs []any
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
 
 	debugMode bool
 }
@@ -95,6 +218,7 @@ func (q *_dont_use_user_query_builder) SQL() (string, error) {
 	if q.mode == "" {
 		q.mode = "select"
 	}
+	q.args = nil
 
 	var query string
 	var err error
@@ -118,17 +242,29 @@ func (q *_dont_use_user_query_builder) SQL() (string, error) {
 type UserColumn string
 
 var UserColumns = struct {
-	ID   UserColumn
-	Name UserColumn
+	ID       UserColumn
+	Name     UserColumn
+	Email    UserColumn
+	Nickname UserColumn
+	Age      UserColumn
 }{
-	ID:   UserColumn("id"),
-	Name: UserColumn("name"),
+	ID:       UserColumn("id"),
+	Name:     UserColumn("name"),
+	Email:    UserColumn("email_address"),
+	Nickname: UserColumn("nickname"),
+	Age:      UserColumn("age"),
 }
 
 func (q *_dont_use_user_query_builder) getPlaceholder() string {
 	return "?"
 }
 
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_user_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
 func (q *_dont_use_user_query_builder) Limit(l int) UserQueryBuilder {
 	q.mode = "select"
 	q.limit = l
@@ -145,6 +281,9 @@ func (q User) Values() []interface{} {
 	var values []interface{}
 	values = append(values, &q.ID)
 	values = append(values, &q.Name)
+	values = append(values, &q.Email)
+	values = append(values, &q.Nickname)
+	values = append(values, &q.Age)
 
 	return values
 }
@@ -154,7 +293,9 @@ func (q *_dont_use_user_query_builder) Debug() UserQueryBuilder {
 	return q
 }
 
+// UsersFromRows scans every column of User from rows and closes them.
 func UsersFromRows(rows *sql.Rows) ([]User, error) {
+	defer rows.Close()
 	var Users []User
 	for rows.Next() {
 		var m User
@@ -163,13 +304,19 @@ func UsersFromRows(rows *sql.Rows) ([]User, error) {
 			&m.ID,
 
 			&m.Name,
+
+			&m.Email,
+
+			&m.Nickname,
+
+			&m.Age,
 		)
 		if err != nil {
 			return nil, err
 		}
 		Users = append(Users, m)
 	}
-	return Users, nil
+	return Users, rows.Err()
 }
 
 func UserFromRow(row *sql.Row) (User, error) {
@@ -180,6 +327,9 @@ func UserFromRow(row *sql.Row) (User, error) {
 	err := row.Scan(
 		&q.ID,
 		&q.Name,
+		&q.Email,
+		&q.Nickname,
+		&q.Age,
 	)
 	if err != nil {
 		return User{}, err
@@ -188,253 +338,4011 @@ func UserFromRow(row *sql.Row) (User, error) {
 	return q, nil
 }
 
-func (q *_dont_use_user_query_builder) Update(db *sql.DB) (sql.Result, error) {
+func (q *_dont_use_user_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
 	q.mode = "update"
-	args := append(q.setArgs, q.whereArgs...)
 	query, err := q.SQL()
 	if err != nil {
 		return nil, err
 	}
-	return db.Exec(query, args...)
+	return db.ExecContext(ctx, query, q.args...)
 }
 
-func (q *_dont_use_user_query_builder) Delete(db *sql.DB) (sql.Result, error) {
+func (q *_dont_use_user_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
 	q.mode = "delete"
 	query, err := q.SQL()
 	if err != nil {
 		return nil, err
 	}
-	return db.Exec(query, q.whereArgs...)
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]User, error) {
+	return q.fetch(ctx, db)
 }
 
-func (q *_dont_use_user_query_builder) Fetch(db *sql.DB) ([]User, error) {
+func (q *_dont_use_user_query_builder) fetch(ctx context.Context, db qb.Executor) ([]User, error) {
 	q.mode = "select"
 	query, err := q.SQL()
 	if err != nil {
 		return nil, err
 	}
-	rows, err := db.Query(query, q.whereArgs...)
+	rows, err := db.QueryContext(ctx, query, q.args...)
 	if err != nil {
 		return nil, err
 	}
-	return UsersFromRows(rows)
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
 }
 
-func (q *_dont_use_user_query_builder) FindAll(db *sql.DB) ([]User, error) {
-	return q.Fetch(db)
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_user_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []User) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (q *_dont_use_user_query_builder) First(db *sql.DB) (User, error) {
-	q.mode = "select"
-	q.orderBy = []string{"ORDER BY id ASC"}
-	q.Limit(1)
-	query, err := q.SQL()
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_user_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (User, error) {
+	m, err := q.scanRow(row)
 	if err != nil {
 		return User{}, err
 	}
-	row := db.QueryRow(query, q.whereArgs...)
-	if row.Err() != nil {
-		return User{}, row.Err()
+	records := []User{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return User{}, err
 	}
-	return UserFromRow(row)
+	return records[0], nil
 }
 
-func (q *_dont_use_user_query_builder) Last(db *sql.DB) (User, error) {
-	q.mode = "select"
-	q.orderBy = []string{"ORDER BY id DESC"}
-	q.Limit(1)
-	query, err := q.SQL()
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_user_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(User{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m User
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(User{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(User{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_user_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]User) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(UserColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// UserPage is a page of Users returned by Paginate, Page counts from 1.
+type UserPage struct {
+	Items    []User
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_user_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
 	if err != nil {
-		return User{}, err
+		return 0, err
 	}
-	row := db.QueryRow(query, q.whereArgs...)
-	if row.Err() != nil {
-		return User{}, row.Err()
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
 	}
-	return UserFromRow(row)
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
 }
 
-func (q *_dont_use_user_query_builder) OrderByAsc(column UserColumn) UserQueryBuilder {
-	q.mode = "select"
-	q.orderBy = append(q.orderBy, fmt.Sprintf("%s ASC", string(column)))
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_user_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserPage, error) {
+	if page < 1 || perPage < 1 {
+		return UserPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return UserPage{}, err
+	}
+	result := UserPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// UserCursorPage is a page of Users returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type UserCursorPage struct {
+	Items []User
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_user_query_builder) After(cursor string) UserQueryBuilder {
+	q.after = cursor
 	return q
 }
 
-func (q *_dont_use_user_query_builder) OrderByDesc(column UserColumn) UserQueryBuilder {
-	q.mode = "select"
-	q.orderBy = append(q.orderBy, fmt.Sprintf("%s DESC", string(column)))
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_user_query_builder) Before(cursor string) UserQueryBuilder {
+	q.before = cursor
 	return q
 }
 
-func (q *_dont_use_user_query_builder) sqlSelect() (string, error) {
-	if q.projected == nil {
-		q.projected = append(q.projected, "*")
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_user_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []UserColumn{UserColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
 	}
-	base := fmt.Sprintf("SELECT %s FROM users", strings.Join(q.projected, ", "))
-
-	var wheres []string
+	return keys
+}
 
-	if q.where.ID.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "id", q.where.ID.operator, fmt.Sprint(q.where.ID.argument)))
+func (q *_dont_use_user_query_builder) encodeCursor(record User, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, UserColumn(key.Column))
 	}
+	return qb.EncodeCursor(values)
+}
 
-	if q.where.Name.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "name", q.where.Name.operator, fmt.Sprint(q.where.Name.argument)))
+func (q *_dont_use_user_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record User
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, UserColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown User column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
 	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
 
-	if len(wheres) > 0 {
-		base += " WHERE " + strings.Join(wheres, " AND ")
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_user_query_builder) Page(ctx context.Context, db qb.Executor, size int) (UserCursorPage, error) {
+	if size <= 0 {
+		return UserCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return UserCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return UserCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
 	}
+	keys := q.keyset()
+	columns := make([]UserColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = UserColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return UserCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
 
-	if len(q.orderBy) > 0 {
-		base += fmt.Sprintf(" ORDER BY %s", strings.Join(q.orderBy, ", "))
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return UserCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
 	}
 
-	if q.limit != 0 {
-		base += " LIMIT " + fmt.Sprint(q.limit)
+	page := UserCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
 	}
-	if q.offset != 0 {
-		base += " OFFSET " + fmt.Sprint(q.offset)
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return UserCursorPage{}, err
+		}
 	}
-	return base, nil
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return UserCursorPage{}, err
+		}
+	}
+	return page, nil
 }
 
-func (q *_dont_use_user_query_builder) sqlUpdate() (string, error) {
-	base := fmt.Sprintf("UPDATE users ")
+func (q *_dont_use_user_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]User, error) {
+	return q.Fetch(ctx, db)
+}
 
-	var wheres []string
-	var sets []string
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_user_query_builder) GroupBy(columns ...UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
 
-	if q.where.ID.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "id", q.where.ID.operator, fmt.Sprint(q.where.ID.argument)))
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_user_query_builder) Having(fragment string, args ...any) UserQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_user_query_builder) HavingCount(operator qb.Operator, count int64) UserQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// UserGroup is a group of Users returned by FetchGroups, only the
+// GroupBy fields of the embedded User are set.
+type UserGroup struct {
+	User
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_user_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]UserGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
 	}
-	if q.set.ID != "" {
-		sets = append(sets, fmt.Sprintf("%s = %s", "id", fmt.Sprint(q.set.ID)))
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
 	}
-
-	if q.where.Name.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "name", q.where.Name.operator, fmt.Sprint(q.where.Name.argument)))
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
 	}
-	if q.set.Name != "" {
-		sets = append(sets, fmt.Sprintf("%s = %s", "name", fmt.Sprint(q.set.Name)))
+	defer rows.Close()
+	var groups []UserGroup
+	for rows.Next() {
+		var g UserGroup
+		if err := rows.Scan(append(q.scanTargets(&g.User), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
 	}
+	return groups, rows.Err()
+}
 
-	if len(sets) > 0 {
-		base += "SET " + strings.Join(sets, " , ")
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_user_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
 	}
+	return db.QueryContext(ctx, query, q.args...)
+}
 
-	if len(wheres) > 0 {
-		base += " WHERE " + strings.Join(wheres, " AND ")
+// PluckID returns the id of every matching row.
+func (q *_dont_use_user_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
 	}
-
-	return base, nil
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
 }
 
-func (q *_dont_use_user_query_builder) sqlDelete() (string, error) {
-	base := fmt.Sprintf("DELETE FROM users")
-
-	var wheres []string
-
-	if q.where.ID.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "id", q.where.ID.operator, fmt.Sprint(q.where.ID.argument)))
+// PluckName returns the name of every matching row.
+func (q *_dont_use_user_query_builder) PluckName(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Name}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
 	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
 
-	if q.where.Name.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "name", q.where.Name.operator, fmt.Sprint(q.where.Name.argument)))
+// PluckEmail returns the email_address of every matching row.
+func (q *_dont_use_user_query_builder) PluckEmail(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Email}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
 	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
 
-	if len(wheres) > 0 {
-		base += " WHERE " + strings.Join(wheres, " AND ")
+// PluckNickname returns the nickname of every matching row.
+func (q *_dont_use_user_query_builder) PluckNickname(ctx context.Context, db qb.Executor) ([]*string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Nickname}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
 	}
-
-	return base, nil
+	return qb.Collect(rows, func(v **string) []any { return []any{v} })
 }
 
-func (q *_dont_use_user_query_builder) WhereIDGE(ID int64) UserQueryBuilder {
-	q.whereArgs = append(q.whereArgs, ID)
-	q.where.ID.argument = q.getPlaceholder()
-	q.where.ID.operator = ">="
-	return q
+// PluckAge returns the age of every matching row.
+func (q *_dont_use_user_query_builder) PluckAge(ctx context.Context, db qb.Executor) ([]sql.NullInt64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Age}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *sql.NullInt64) []any { return []any{v} })
 }
 
-func (q *_dont_use_user_query_builder) WhereIDGT(ID int64) UserQueryBuilder {
-	q.whereArgs = append(q.whereArgs, ID)
-	q.where.ID.argument = q.getPlaceholder()
-	q.where.ID.operator = ">"
-	return q
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_user_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM users" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
 }
 
-func (q *_dont_use_user_query_builder) WhereIDLE(ID int64) UserQueryBuilder {
-	q.whereArgs = append(q.whereArgs, ID)
-	q.where.ID.argument = q.getPlaceholder()
-	q.where.ID.operator = "<="
-	return q
+func (q *_dont_use_user_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
 }
 
-func (q *_dont_use_user_query_builder) WhereIDLT(ID int64) UserQueryBuilder {
-	q.whereArgs = append(q.whereArgs, ID)
-	q.where.ID.argument = q.getPlaceholder()
-	q.where.ID.operator = "<"
-	return q
+func (q *_dont_use_user_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM users" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
 }
 
-func (q *_dont_use_user_query_builder) WhereID(operator string, ID int64) UserQueryBuilder {
-	q.whereArgs = append(q.whereArgs, ID)
-	q.where.ID.argument = q.getPlaceholder()
-	q.where.ID.operator = operator
-	return q
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
 }
 
-func (q *_dont_use_user_query_builder) WhereIDIs(ID int64) UserQueryBuilder {
-	q.whereArgs = append(q.whereArgs, ID)
-	q.where.ID.argument = q.getPlaceholder()
-	q.where.ID.operator = "="
-	return q
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
 }
 
-func (q *_dont_use_user_query_builder) WhereName(operator string, Name string) UserQueryBuilder {
-	q.whereArgs = append(q.whereArgs, Name)
-	q.where.Name.argument = q.getPlaceholder()
-	q.where.Name.operator = operator
-	return q
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
 }
 
-func (q *_dont_use_user_query_builder) WhereNameIs(Name string) UserQueryBuilder {
-	q.whereArgs = append(q.whereArgs, Name)
-	q.where.Name.argument = q.getPlaceholder()
-	q.where.Name.operator = "="
-	return q
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
 }
 
-func (q *_dont_use_user_query_builder) SetID(ID int64) UserQueryBuilder {
-	q.mode = "update"
-	q.setArgs = append(q.setArgs, ID)
-	q.set.ID = q.getPlaceholder()
-	return q
+func (q *_dont_use_user_query_builder) First(ctx context.Context, db qb.Executor) (User, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
 }
 
-func (q *_dont_use_user_query_builder) SetName(Name string) UserQueryBuilder {
-	q.mode = "update"
-	q.setArgs = append(q.setArgs, Name)
-	q.set.Name = q.getPlaceholder()
-	return q
+func (q *_dont_use_user_query_builder) Last(ctx context.Context, db qb.Executor) (User, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
 }
 
-func (q *_dont_use_user_query_builder) Add(ctx context.Context, record *User, db *sql.DB) error {
-	query := "INSERT INTO users (id, name) VALUES (?, ?)"
-	if q.debugMode {
-		fmt.Printf("Generating query: %s\n", query)
-	}
-	res, err := db.ExecContext(ctx, query, record.ID, record.Name)
+func (q *_dont_use_user_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (User, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
 	if err != nil {
-		return err
+		return User{}, err
 	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
 
-	id, err := res.LastInsertId()
+func (q *_dont_use_user_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	record.ID = id
-	return nil
+	return db.ExecContext(ctx, query, q.args...)
 }
+
+func (q *_dont_use_user_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) OrderByAsc(column UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) OrderByDesc(column UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_user_query_builder) OrderByRaw(fragment string, args ...any) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of User in order, so they have to match them.
+func (q *_dont_use_user_query_builder) SelectRaw(fragment string, args ...any) UserQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Users keep their zero value.
+func (q *_dont_use_user_query_builder) Select(columns ...UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_user_query_builder) selectedColumns() []UserColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []UserColumn{UserColumns.ID, UserColumns.Name, UserColumns.Email, UserColumns.Nickname, UserColumns.Age}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_user_query_builder) requireSelected(columns ...UserColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_user_query_builder) target(m *User, column UserColumn) interface{} {
+	switch column {
+	case UserColumns.ID:
+		return &m.ID
+	case UserColumns.Name:
+		return &m.Name
+	case UserColumns.Email:
+		return &m.Email
+	case UserColumns.Nickname:
+		return &m.Nickname
+	case UserColumns.Age:
+		return &m.Age
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_user_query_builder) scanTargets(m *User) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_user_query_builder) scanRow(row *sql.Row) (User, error) {
+	var m User
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return User{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_user_query_builder) scanRows(rows *sql.Rows) ([]User, error) {
+	var records []User
+	for rows.Next() {
+		var m User
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_user_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_user_query_builder) joinColumns(columns []UserColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&User{}, column) == nil {
+			return "", fmt.Errorf("unknown User column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_user_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM users", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_user_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE users ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.Name.isSet {
+		rhs := q.set.Name.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Name.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "name", rhs))
+	}
+
+	if q.set.Email.isSet {
+		rhs := q.set.Email.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Email.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "email_address", rhs))
+	}
+
+	if q.set.Nickname.isSet {
+		rhs := q.set.Nickname.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Nickname.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "nickname", rhs))
+	}
+
+	if q.set.Age.isSet {
+		rhs := q.set.Age.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Age.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "age", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_user_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM users")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_user_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_user_query_builder) WhereRaw(fragment string, args ...any) UserQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from users filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_user_query_builder) subquery(column UserColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM users" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_user_query_builder) Or(fn func(b UserQueryBuilder)) UserQueryBuilder {
+	group := &_dont_use_user_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_user_query_builder) And(fn func(b UserQueryBuilder)) UserQueryBuilder {
+	group := &_dont_use_user_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDGE(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDGT(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDLE(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDLT(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereID(operator qb.Operator, ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDIs(ID int64) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereIDIn(IDs ...int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereIDNotIn(IDs ...int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereName compares name using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereName(operator qb.Operator, Name string) UserQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereNameILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(name) LIKE LOWER(?)", Arguments: []any{Name}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: operator, Argument: Name})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNameIs(Name string) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Eq, Argument: Name})
+	return q
+}
+
+// WhereNameIn matches rows whose name is one of Names, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereNameIn(Names ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.In, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameNotIn matches rows whose name is none of Names, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereNameNotIn(Names ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.NotIn, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameLike matches name against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereNameLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNameILike matches name against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereNameILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(name) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereNameStartsWith matches rows whose name starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereNameStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNameContains matches rows whose name contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereNameContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereEmail compares email_address using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereEmail(operator qb.Operator, Email string) UserQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereEmailILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(email_address) LIKE LOWER(?)", Arguments: []any{Email}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: operator, Argument: Email})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereEmailIs(Email string) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.Eq, Argument: Email})
+	return q
+}
+
+// WhereEmailIn matches rows whose email_address is one of Emails, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereEmailIn(Emails ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.In, Arguments: qb.Args(Emails)})
+	return q
+}
+
+// WhereEmailNotIn matches rows whose email_address is none of Emails, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereEmailNotIn(Emails ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.NotIn, Arguments: qb.Args(Emails)})
+	return q
+}
+
+// WhereEmailLike matches email_address against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereEmailLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereEmailILike matches email_address against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereEmailILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(email_address) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereEmailStartsWith matches rows whose email_address starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereEmailStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "email_address LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereEmailContains matches rows whose email_address contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereEmailContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "email_address LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereNickname compares nickname using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereNickname(operator qb.Operator, Nickname *string) UserQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereNicknameILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(nickname) LIKE LOWER(?)", Arguments: []any{Nickname}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: operator, Argument: Nickname})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIs(Nickname *string) UserQueryBuilder {
+	if Nickname == nil {
+		return q.WhereNicknameIsNull()
+	}
+
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Eq, Argument: Nickname})
+	return q
+}
+
+// WhereNicknameIn matches rows whose nickname is one of Nicknames, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereNicknameIn(Nicknames ...*string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.In, Arguments: qb.Args(Nicknames)})
+	return q
+}
+
+// WhereNicknameNotIn matches rows whose nickname is none of Nicknames, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereNicknameNotIn(Nicknames ...*string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.NotIn, Arguments: qb.Args(Nicknames)})
+	return q
+}
+
+// WhereNicknameLike matches nickname against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereNicknameLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNicknameILike matches nickname against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereNicknameILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(nickname) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereNicknameStartsWith matches rows whose nickname starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereNicknameStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "nickname LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNicknameContains matches rows whose nickname contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereNicknameContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "nickname LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIsNull() UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Is, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIsNotNull() UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.IsNot, Literal: "NULL"})
+	return q
+}
+
+// WhereAge compares age using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereAge(operator qb.Operator, Age sql.NullInt64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "age", Operator: operator, Argument: Age})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereAgeIs(Age sql.NullInt64) UserQueryBuilder {
+	if !Age.Valid {
+		return q.WhereAgeIsNull()
+	}
+
+	q.where = append(q.where, qb.Predicate{Column: "age", Operator: qb.Eq, Argument: Age})
+	return q
+}
+
+// WhereAgeIn matches rows whose age is one of Ages, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereAgeIn(Ages ...sql.NullInt64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "age", Operator: qb.In, Arguments: qb.Args(Ages)})
+	return q
+}
+
+// WhereAgeNotIn matches rows whose age is none of Ages, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereAgeNotIn(Ages ...sql.NullInt64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "age", Operator: qb.NotIn, Arguments: qb.Args(Ages)})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereAgeIsNull() UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "age", Operator: qb.Is, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereAgeIsNotNull() UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "age", Operator: qb.IsNot, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetID(ID int64) UserQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetName(Name string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Name.argument = Name
+	q.set.Name.literal = ""
+	q.set.Name.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetEmail(Email string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Email.argument = Email
+	q.set.Email.literal = ""
+	q.set.Email.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetNickname(Nickname *string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Nickname.argument = Nickname
+	q.set.Nickname.literal = ""
+	q.set.Nickname.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetNicknameNull() UserQueryBuilder {
+	q.mode = "update"
+	q.set.Nickname.literal = "NULL"
+	q.set.Nickname.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetAge(Age sql.NullInt64) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Age.argument = Age
+	q.set.Age.literal = ""
+	q.set.Age.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetAgeNull() UserQueryBuilder {
+	q.mode = "update"
+	q.set.Age.literal = "NULL"
+	q.set.Age.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) Add(ctx context.Context, db qb.Executor, record *User) error {
+	query := "INSERT INTO users (name, email_address, nickname, age) VALUES (?, ?, ?, ?)"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	res, err := db.ExecContext(ctx, query, record.Name, record.Email, record.Nickname, record.Age)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	record.ID = int64(id)
+
+	return nil
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from LastInsertId assuming the rows of a statement get
+// consecutive ids, which innodb_autoinc_lock_mode=2 doesn't guarantee.
+func (q *_dont_use_user_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*User) error {
+	const chunkSize = 65535 / 4
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_user_query_builder) addMany(ctx context.Context, db qb.Executor, records []*User) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Name), q.bind(record.Email), q.bind(record.Nickname), q.bind(record.Age)}, ", ")+")")
+	}
+	query := "INSERT INTO users (name, email_address, nickname, age) VALUES " + strings.Join(values, ", ")
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	res, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	// MySQL reports the id of the first row, rows of a single statement get consecutive ids.
+	for i, record := range records {
+		record.ID = int64(id + int64(i))
+	}
+
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_user_query_builder) OnConflictUpdate(columns ...UserColumn) UserQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]UserColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. MySQL picks the conflicting row by the
+// unique keys of the table, conflictColumns only excludes columns from the update.
+func (q *_dont_use_user_query_builder) Upsert(ctx context.Context, db qb.Executor, record *User, conflictColumns ...UserColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []UserColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []UserColumn{UserColumns.Name, UserColumns.Email, UserColumns.Nickname, UserColumns.Age} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO users (name, email_address, nickname, age) VALUES (" + strings.Join([]string{q.bind(record.Name), q.bind(record.Email), q.bind(record.Nickname), q.bind(record.Age)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", column, column))
+	}
+	// makes LastInsertId report the id of the updated row.
+	sets = append(sets, "id = LAST_INSERT_ID(id)")
+
+	if len(sets) == 0 {
+		sets = append(sets, "name = name")
+	}
+	query += " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	res, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	record.ID = int64(id)
+	return nil
+
+}
+
+
+
+
+type CountryQueryBuilder interface {
+	WhereCodeIs(string) CountryQueryBuilder
+	WhereCode(operator qb.Operator, rhs string) CountryQueryBuilder
+	WhereCodeIn(...string) CountryQueryBuilder
+	WhereCodeNotIn(...string) CountryQueryBuilder
+
+	WhereCodeLike(pattern string) CountryQueryBuilder
+	WhereCodeILike(pattern string) CountryQueryBuilder
+	WhereCodeStartsWith(prefix string) CountryQueryBuilder
+	WhereCodeContains(substring string) CountryQueryBuilder
+
+	WhereNameIs(string) CountryQueryBuilder
+	WhereName(operator qb.Operator, rhs string) CountryQueryBuilder
+	WhereNameIn(...string) CountryQueryBuilder
+	WhereNameNotIn(...string) CountryQueryBuilder
+
+	WhereNameLike(pattern string) CountryQueryBuilder
+	WhereNameILike(pattern string) CountryQueryBuilder
+	WhereNameStartsWith(prefix string) CountryQueryBuilder
+	WhereNameContains(substring string) CountryQueryBuilder
+
+	WhereRaw(fragment string, args ...any) CountryQueryBuilder
+
+	Or(func(b CountryQueryBuilder)) CountryQueryBuilder
+	And(func(b CountryQueryBuilder)) CountryQueryBuilder
+
+	OrderByAsc(column CountryColumn) CountryQueryBuilder
+	OrderByDesc(column CountryColumn) CountryQueryBuilder
+	OrderByRaw(fragment string, args ...any) CountryQueryBuilder
+
+	Select(columns ...CountryColumn) CountryQueryBuilder
+	SelectRaw(fragment string, args ...any) CountryQueryBuilder
+
+	GroupBy(columns ...CountryColumn) CountryQueryBuilder
+	Having(fragment string, args ...any) CountryQueryBuilder
+	HavingCount(operator qb.Operator, count int64) CountryQueryBuilder
+
+	After(cursor string) CountryQueryBuilder
+	Before(cursor string) CountryQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (CountryCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (CountryPage, error)
+
+	Limit(int) CountryQueryBuilder
+	Offset(int) CountryQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Country, error)
+	Last(ctx context.Context, db qb.Executor) (Country, error)
+
+	FindByCode(ctx context.Context, db qb.Executor, Code string) (Country, error)
+	DeleteByCode(ctx context.Context, db qb.Executor, Code string) (sql.Result, error)
+	UpdateByCode(ctx context.Context, db qb.Executor, Code string) (sql.Result, error)
+
+	SetCode(string) CountryQueryBuilder
+
+	SetName(string) CountryQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Country) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Country) error
+
+	OnConflictUpdate(columns ...CountryColumn) CountryQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Country, conflictColumns ...CountryColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Country, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Country, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Country, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Country) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]CountryGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckCode(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckName(ctx context.Context, db qb.Executor) ([]string, error)
+
+	SQL() (string, error)
+
+	Debug() CountryQueryBuilder
+}
+
+type _dont_use_country_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		Code struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Name struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []CountryColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []CountryColumn
+
+	selected  []CountryColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Country) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Countrys() CountryQueryBuilder {
+	return &_dont_use_country_query_builder{}
+}
+
+func (q *_dont_use_country_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type CountryColumn string
+
+var CountryColumns = struct {
+	Code CountryColumn
+	Name CountryColumn
+}{
+	Code: CountryColumn("code"),
+	Name: CountryColumn("name"),
+}
+
+func (q *_dont_use_country_query_builder) getPlaceholder() string {
+	return "?"
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_country_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_country_query_builder) Limit(l int) CountryQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_country_query_builder) Offset(l int) CountryQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Country) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.Code)
+	values = append(values, &q.Name)
+
+	return values
+}
+
+func (q *_dont_use_country_query_builder) Debug() CountryQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// CountrysFromRows scans every column of Country from rows and closes them.
+func CountrysFromRows(rows *sql.Rows) ([]Country, error) {
+	defer rows.Close()
+	var Countrys []Country
+	for rows.Next() {
+		var m Country
+		err := rows.Scan(
+
+			&m.Code,
+
+			&m.Name,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Countrys = append(Countrys, m)
+	}
+	return Countrys, rows.Err()
+}
+
+func CountryFromRow(row *sql.Row) (Country, error) {
+	if row.Err() != nil {
+		return Country{}, row.Err()
+	}
+	var q Country
+	err := row.Scan(
+		&q.Code,
+		&q.Name,
+	)
+	if err != nil {
+		return Country{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_country_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_country_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_country_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Country, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_country_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Country, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_country_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Country) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_country_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Country, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Country{}, err
+	}
+	records := []Country{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Country{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_country_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Country, error] {
+	return func(yield func(Country, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Country{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Country
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Country{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Country{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_country_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Country) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(CountryColumns.Code); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "code ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "code", Operator: qb.Gt, Argument: last.Code})
+	}
+}
+
+// CountryPage is a page of Countrys returned by Paginate, Page counts from 1.
+type CountryPage struct {
+	Items    []Country
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_country_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_country_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (CountryPage, error) {
+	if page < 1 || perPage < 1 {
+		return CountryPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return CountryPage{}, err
+	}
+	result := CountryPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return CountryPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// CountryCursorPage is a page of Countrys returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type CountryCursorPage struct {
+	Items []Country
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_country_query_builder) After(cursor string) CountryQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_country_query_builder) Before(cursor string) CountryQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_country_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []CountryColumn{CountryColumns.Code} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_country_query_builder) encodeCursor(record Country, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, CountryColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_country_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Country
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, CountryColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Country column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_country_query_builder) Page(ctx context.Context, db qb.Executor, size int) (CountryCursorPage, error) {
+	if size <= 0 {
+		return CountryCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return CountryCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return CountryCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]CountryColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = CountryColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return CountryCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return CountryCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return CountryCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := CountryCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return CountryCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return CountryCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_country_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Country, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_country_query_builder) GroupBy(columns ...CountryColumn) CountryQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_country_query_builder) Having(fragment string, args ...any) CountryQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_country_query_builder) HavingCount(operator qb.Operator, count int64) CountryQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// CountryGroup is a group of Countrys returned by FetchGroups, only the
+// GroupBy fields of the embedded Country are set.
+type CountryGroup struct {
+	Country
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_country_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]CountryGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []CountryGroup
+	for rows.Next() {
+		var g CountryGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Country), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_country_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckCode returns the code of every matching row.
+func (q *_dont_use_country_query_builder) PluckCode(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []CountryColumn{CountryColumns.Code}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckName returns the name of every matching row.
+func (q *_dont_use_country_query_builder) PluckName(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []CountryColumn{CountryColumns.Name}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_country_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM countries" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_country_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_country_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM countries" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+func (q *_dont_use_country_query_builder) First(ctx context.Context, db qb.Executor) (Country, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "code ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Country{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Country{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_country_query_builder) Last(ctx context.Context, db qb.Executor) (Country, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "code DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Country{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Country{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_country_query_builder) FindByCode(ctx context.Context, db qb.Executor, Code string) (Country, error) {
+	q.WhereCodeIs(Code)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Country{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Country{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_country_query_builder) DeleteByCode(ctx context.Context, db qb.Executor, Code string) (sql.Result, error) {
+	q.WhereCodeIs(Code)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_country_query_builder) UpdateByCode(ctx context.Context, db qb.Executor, Code string) (sql.Result, error) {
+	q.WhereCodeIs(Code)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_country_query_builder) OrderByAsc(column CountryColumn) CountryQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_country_query_builder) OrderByDesc(column CountryColumn) CountryQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_country_query_builder) OrderByRaw(fragment string, args ...any) CountryQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Country in order, so they have to match them.
+func (q *_dont_use_country_query_builder) SelectRaw(fragment string, args ...any) CountryQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Countrys keep their zero value.
+func (q *_dont_use_country_query_builder) Select(columns ...CountryColumn) CountryQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_country_query_builder) selectedColumns() []CountryColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []CountryColumn{CountryColumns.Code, CountryColumns.Name}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_country_query_builder) requireSelected(columns ...CountryColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_country_query_builder) target(m *Country, column CountryColumn) interface{} {
+	switch column {
+	case CountryColumns.Code:
+		return &m.Code
+	case CountryColumns.Name:
+		return &m.Name
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_country_query_builder) scanTargets(m *Country) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_country_query_builder) scanRow(row *sql.Row) (Country, error) {
+	var m Country
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Country{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_country_query_builder) scanRows(rows *sql.Rows) ([]Country, error) {
+	var records []Country
+	for rows.Next() {
+		var m Country
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_country_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_country_query_builder) joinColumns(columns []CountryColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Country{}, column) == nil {
+			return "", fmt.Errorf("unknown Country column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_country_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM countries", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_country_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE countries ")
+
+	var sets []string
+
+	if q.set.Code.isSet {
+		rhs := q.set.Code.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Code.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "code", rhs))
+	}
+
+	if q.set.Name.isSet {
+		rhs := q.set.Name.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Name.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "name", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_country_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM countries")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_country_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_country_query_builder) WhereRaw(fragment string, args ...any) CountryQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from countries filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_country_query_builder) subquery(column CountryColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM countries" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_country_query_builder) Or(fn func(b CountryQueryBuilder)) CountryQueryBuilder {
+	group := &_dont_use_country_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_country_query_builder) And(fn func(b CountryQueryBuilder)) CountryQueryBuilder {
+	group := &_dont_use_country_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+// WhereCode compares code using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_country_query_builder) WhereCode(operator qb.Operator, Code string) CountryQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereCodeILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(code) LIKE LOWER(?)", Arguments: []any{Code}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: operator, Argument: Code})
+	return q
+}
+
+func (q *_dont_use_country_query_builder) WhereCodeIs(Code string) CountryQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.Eq, Argument: Code})
+	return q
+}
+
+// WhereCodeIn matches rows whose code is one of Codes, an empty list matches nothing.
+func (q *_dont_use_country_query_builder) WhereCodeIn(Codes ...string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.In, Arguments: qb.Args(Codes)})
+	return q
+}
+
+// WhereCodeNotIn matches rows whose code is none of Codes, an empty list matches everything.
+func (q *_dont_use_country_query_builder) WhereCodeNotIn(Codes ...string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.NotIn, Arguments: qb.Args(Codes)})
+	return q
+}
+
+// WhereCodeLike matches code against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_country_query_builder) WhereCodeLike(pattern string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereCodeILike matches code against pattern ignoring case.
+func (q *_dont_use_country_query_builder) WhereCodeILike(pattern string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(code) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereCodeStartsWith matches rows whose code starts with prefix, prefix is matched literally.
+func (q *_dont_use_country_query_builder) WhereCodeStartsWith(prefix string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "code LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereCodeContains matches rows whose code contains substring, substring is matched literally.
+func (q *_dont_use_country_query_builder) WhereCodeContains(substring string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "code LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereName compares name using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_country_query_builder) WhereName(operator qb.Operator, Name string) CountryQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereNameILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(name) LIKE LOWER(?)", Arguments: []any{Name}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: operator, Argument: Name})
+	return q
+}
+
+func (q *_dont_use_country_query_builder) WhereNameIs(Name string) CountryQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Eq, Argument: Name})
+	return q
+}
+
+// WhereNameIn matches rows whose name is one of Names, an empty list matches nothing.
+func (q *_dont_use_country_query_builder) WhereNameIn(Names ...string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.In, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameNotIn matches rows whose name is none of Names, an empty list matches everything.
+func (q *_dont_use_country_query_builder) WhereNameNotIn(Names ...string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.NotIn, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameLike matches name against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_country_query_builder) WhereNameLike(pattern string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNameILike matches name against pattern ignoring case.
+func (q *_dont_use_country_query_builder) WhereNameILike(pattern string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(name) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereNameStartsWith matches rows whose name starts with prefix, prefix is matched literally.
+func (q *_dont_use_country_query_builder) WhereNameStartsWith(prefix string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNameContains matches rows whose name contains substring, substring is matched literally.
+func (q *_dont_use_country_query_builder) WhereNameContains(substring string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_country_query_builder) SetCode(Code string) CountryQueryBuilder {
+	q.mode = "update"
+	q.set.Code.argument = Code
+	q.set.Code.literal = ""
+	q.set.Code.isSet = true
+	return q
+}
+
+func (q *_dont_use_country_query_builder) SetName(Name string) CountryQueryBuilder {
+	q.mode = "update"
+	q.set.Name.argument = Name
+	q.set.Name.literal = ""
+	q.set.Name.isSet = true
+	return q
+}
+
+func (q *_dont_use_country_query_builder) Add(ctx context.Context, db qb.Executor, record *Country) error {
+	query := "INSERT INTO countries (code, name) VALUES (?, ?)"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, record.Code, record.Name)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+func (q *_dont_use_country_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Country) error {
+	const chunkSize = 65535 / 2
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_country_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Country) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Code), q.bind(record.Name)}, ", ")+")")
+	}
+	query := "INSERT INTO countries (code, name) VALUES " + strings.Join(values, ", ")
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_country_query_builder) OnConflictUpdate(columns ...CountryColumn) CountryQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]CountryColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. MySQL picks the conflicting row by the
+// unique keys of the table, conflictColumns only excludes columns from the update.
+func (q *_dont_use_country_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Country, conflictColumns ...CountryColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []CountryColumn{CountryColumns.Code}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []CountryColumn{CountryColumns.Code, CountryColumns.Name} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO countries (code, name) VALUES (" + strings.Join([]string{q.bind(record.Code), q.bind(record.Name)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", column, column))
+	}
+
+	if len(sets) == 0 {
+		sets = append(sets, "code = code")
+	}
+	query += " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	return err
+
+}
+
+
+
+
+type UserRoleQueryBuilder interface {
+	WhereUserIDIs(int64) UserRoleQueryBuilder
+	WhereUserID(operator qb.Operator, rhs int64) UserRoleQueryBuilder
+	WhereUserIDIn(...int64) UserRoleQueryBuilder
+	WhereUserIDNotIn(...int64) UserRoleQueryBuilder
+
+	WhereUserIDGT(int64) UserRoleQueryBuilder
+	WhereUserIDGE(int64) UserRoleQueryBuilder
+	WhereUserIDLT(int64) UserRoleQueryBuilder
+	WhereUserIDLE(int64) UserRoleQueryBuilder
+
+	WhereRoleIDIs(int64) UserRoleQueryBuilder
+	WhereRoleID(operator qb.Operator, rhs int64) UserRoleQueryBuilder
+	WhereRoleIDIn(...int64) UserRoleQueryBuilder
+	WhereRoleIDNotIn(...int64) UserRoleQueryBuilder
+
+	WhereRoleIDGT(int64) UserRoleQueryBuilder
+	WhereRoleIDGE(int64) UserRoleQueryBuilder
+	WhereRoleIDLT(int64) UserRoleQueryBuilder
+	WhereRoleIDLE(int64) UserRoleQueryBuilder
+
+	WhereRaw(fragment string, args ...any) UserRoleQueryBuilder
+
+	Or(func(b UserRoleQueryBuilder)) UserRoleQueryBuilder
+	And(func(b UserRoleQueryBuilder)) UserRoleQueryBuilder
+
+	OrderByAsc(column UserRoleColumn) UserRoleQueryBuilder
+	OrderByDesc(column UserRoleColumn) UserRoleQueryBuilder
+	OrderByRaw(fragment string, args ...any) UserRoleQueryBuilder
+
+	Select(columns ...UserRoleColumn) UserRoleQueryBuilder
+	SelectRaw(fragment string, args ...any) UserRoleQueryBuilder
+
+	GroupBy(columns ...UserRoleColumn) UserRoleQueryBuilder
+	Having(fragment string, args ...any) UserRoleQueryBuilder
+	HavingCount(operator qb.Operator, count int64) UserRoleQueryBuilder
+
+	After(cursor string) UserRoleQueryBuilder
+	Before(cursor string) UserRoleQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (UserRoleCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserRolePage, error)
+
+	Limit(int) UserRoleQueryBuilder
+	Offset(int) UserRoleQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (UserRole, error)
+	Last(ctx context.Context, db qb.Executor) (UserRole, error)
+
+	FindByKey(ctx context.Context, db qb.Executor, key UserRoleKey) (UserRole, error)
+	DeleteByKey(ctx context.Context, db qb.Executor, key UserRoleKey) (sql.Result, error)
+	UpdateByKey(ctx context.Context, db qb.Executor, key UserRoleKey) (sql.Result, error)
+
+	SetUserID(int64) UserRoleQueryBuilder
+
+	SetRoleID(int64) UserRoleQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *UserRole) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*UserRole) error
+
+	OnConflictUpdate(columns ...UserRoleColumn) UserRoleQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *UserRole, conflictColumns ...UserRoleColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]UserRole, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]UserRole, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[UserRole, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]UserRole) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]UserRoleGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckUserID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckRoleID(ctx context.Context, db qb.Executor) ([]int64, error)
+
+	SumUserID(ctx context.Context, db qb.Executor) (int64, error)
+	MinUserID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxUserID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgUserID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SumRoleID(ctx context.Context, db qb.Executor) (int64, error)
+	MinRoleID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxRoleID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgRoleID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() UserRoleQueryBuilder
+}
+
+type _dont_use_userrole_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		UserID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		RoleID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []UserRoleColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []UserRoleColumn
+
+	selected  []UserRoleColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []UserRole) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func UserRoles() UserRoleQueryBuilder {
+	return &_dont_use_userrole_query_builder{}
+}
+
+func (q *_dont_use_userrole_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type UserRoleColumn string
+
+var UserRoleColumns = struct {
+	UserID UserRoleColumn
+	RoleID UserRoleColumn
+}{
+	UserID: UserRoleColumn("user_id"),
+	RoleID: UserRoleColumn("role_id"),
+}
+
+func (q *_dont_use_userrole_query_builder) getPlaceholder() string {
+	return "?"
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_userrole_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_userrole_query_builder) Limit(l int) UserRoleQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) Offset(l int) UserRoleQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q UserRole) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.UserID)
+	values = append(values, &q.RoleID)
+
+	return values
+}
+
+func (q *_dont_use_userrole_query_builder) Debug() UserRoleQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// UserRolesFromRows scans every column of UserRole from rows and closes them.
+func UserRolesFromRows(rows *sql.Rows) ([]UserRole, error) {
+	defer rows.Close()
+	var UserRoles []UserRole
+	for rows.Next() {
+		var m UserRole
+		err := rows.Scan(
+
+			&m.UserID,
+
+			&m.RoleID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		UserRoles = append(UserRoles, m)
+	}
+	return UserRoles, rows.Err()
+}
+
+func UserRoleFromRow(row *sql.Row) (UserRole, error) {
+	if row.Err() != nil {
+		return UserRole{}, row.Err()
+	}
+	var q UserRole
+	err := row.Scan(
+		&q.UserID,
+		&q.RoleID,
+	)
+	if err != nil {
+		return UserRole{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_userrole_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_userrole_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_userrole_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]UserRole, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_userrole_query_builder) fetch(ctx context.Context, db qb.Executor) ([]UserRole, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_userrole_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []UserRole) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_userrole_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (UserRole, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return UserRole{}, err
+	}
+	records := []UserRole{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return UserRole{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_userrole_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[UserRole, error] {
+	return func(yield func(UserRole, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(UserRole{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m UserRole
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(UserRole{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(UserRole{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_userrole_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]UserRole) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(UserRoleColumns.UserID, UserRoleColumns.RoleID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "user_id ASC"}, {SQL: "role_id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Raw: "(user_id, role_id) > (?, ?)", Arguments: []any{last.UserID, last.RoleID}})
+	}
+}
+
+// UserRolePage is a page of UserRoles returned by Paginate, Page counts from 1.
+type UserRolePage struct {
+	Items    []UserRole
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_userrole_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_userrole_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserRolePage, error) {
+	if page < 1 || perPage < 1 {
+		return UserRolePage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return UserRolePage{}, err
+	}
+	result := UserRolePage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserRolePage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// UserRoleCursorPage is a page of UserRoles returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type UserRoleCursorPage struct {
+	Items []UserRole
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_userrole_query_builder) After(cursor string) UserRoleQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_userrole_query_builder) Before(cursor string) UserRoleQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_userrole_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []UserRoleColumn{UserRoleColumns.UserID, UserRoleColumns.RoleID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_userrole_query_builder) encodeCursor(record UserRole, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, UserRoleColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_userrole_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record UserRole
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, UserRoleColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown UserRole column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_userrole_query_builder) Page(ctx context.Context, db qb.Executor, size int) (UserRoleCursorPage, error) {
+	if size <= 0 {
+		return UserRoleCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return UserRoleCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return UserRoleCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]UserRoleColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = UserRoleColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return UserRoleCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return UserRoleCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserRoleCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := UserRoleCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return UserRoleCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return UserRoleCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_userrole_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]UserRole, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_userrole_query_builder) GroupBy(columns ...UserRoleColumn) UserRoleQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_userrole_query_builder) Having(fragment string, args ...any) UserRoleQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_userrole_query_builder) HavingCount(operator qb.Operator, count int64) UserRoleQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// UserRoleGroup is a group of UserRoles returned by FetchGroups, only the
+// GroupBy fields of the embedded UserRole are set.
+type UserRoleGroup struct {
+	UserRole
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_userrole_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]UserRoleGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []UserRoleGroup
+	for rows.Next() {
+		var g UserRoleGroup
+		if err := rows.Scan(append(q.scanTargets(&g.UserRole), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_userrole_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckUserID returns the user_id of every matching row.
+func (q *_dont_use_userrole_query_builder) PluckUserID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserRoleColumn{UserRoleColumns.UserID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckRoleID returns the role_id of every matching row.
+func (q *_dont_use_userrole_query_builder) PluckRoleID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserRoleColumn{UserRoleColumns.RoleID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_userrole_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM user_roles" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_userrole_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_userrole_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM user_roles" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumUserID returns the sum of user_id over the matching rows, 0 if there are none.
+func (q *_dont_use_userrole_query_builder) SumUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(user_id)", &sum)
+	return sum.V, err
+}
+
+// MinUserID returns the smallest user_id of the matching rows, 0 if there are none.
+func (q *_dont_use_userrole_query_builder) MinUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(user_id)", &min)
+	return min.V, err
+}
+
+// MaxUserID returns the largest user_id of the matching rows, 0 if there are none.
+func (q *_dont_use_userrole_query_builder) MaxUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(user_id)", &max)
+	return max.V, err
+}
+
+// AvgUserID returns the average of user_id over the matching rows, 0 if there are none.
+func (q *_dont_use_userrole_query_builder) AvgUserID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(user_id)", &avg)
+	return avg.Float64, err
+}
+
+// SumRoleID returns the sum of role_id over the matching rows, 0 if there are none.
+func (q *_dont_use_userrole_query_builder) SumRoleID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(role_id)", &sum)
+	return sum.V, err
+}
+
+// MinRoleID returns the smallest role_id of the matching rows, 0 if there are none.
+func (q *_dont_use_userrole_query_builder) MinRoleID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(role_id)", &min)
+	return min.V, err
+}
+
+// MaxRoleID returns the largest role_id of the matching rows, 0 if there are none.
+func (q *_dont_use_userrole_query_builder) MaxRoleID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(role_id)", &max)
+	return max.V, err
+}
+
+// AvgRoleID returns the average of role_id over the matching rows, 0 if there are none.
+func (q *_dont_use_userrole_query_builder) AvgRoleID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(role_id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_userrole_query_builder) First(ctx context.Context, db qb.Executor) (UserRole, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "user_id ASC"}, {SQL: "role_id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return UserRole{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return UserRole{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_userrole_query_builder) Last(ctx context.Context, db qb.Executor) (UserRole, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "user_id DESC"}, {SQL: "role_id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return UserRole{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return UserRole{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+// UserRoleKey holds the columns of the composite primary key of UserRole.
+type UserRoleKey struct {
+	UserID int64
+	RoleID int64
+}
+
+func (q *_dont_use_userrole_query_builder) whereKey(key UserRoleKey) {
+	q.WhereUserIDIs(key.UserID)
+	q.WhereRoleIDIs(key.RoleID)
+
+}
+
+func (q *_dont_use_userrole_query_builder) FindByKey(ctx context.Context, db qb.Executor, key UserRoleKey) (UserRole, error) {
+	q.whereKey(key)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return UserRole{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return UserRole{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_userrole_query_builder) DeleteByKey(ctx context.Context, db qb.Executor, key UserRoleKey) (sql.Result, error) {
+	q.whereKey(key)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_userrole_query_builder) UpdateByKey(ctx context.Context, db qb.Executor, key UserRoleKey) (sql.Result, error) {
+	q.whereKey(key)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_userrole_query_builder) OrderByAsc(column UserRoleColumn) UserRoleQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) OrderByDesc(column UserRoleColumn) UserRoleQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_userrole_query_builder) OrderByRaw(fragment string, args ...any) UserRoleQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of UserRole in order, so they have to match them.
+func (q *_dont_use_userrole_query_builder) SelectRaw(fragment string, args ...any) UserRoleQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched UserRoles keep their zero value.
+func (q *_dont_use_userrole_query_builder) Select(columns ...UserRoleColumn) UserRoleQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) selectedColumns() []UserRoleColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []UserRoleColumn{UserRoleColumns.UserID, UserRoleColumns.RoleID}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_userrole_query_builder) requireSelected(columns ...UserRoleColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_userrole_query_builder) target(m *UserRole, column UserRoleColumn) interface{} {
+	switch column {
+	case UserRoleColumns.UserID:
+		return &m.UserID
+	case UserRoleColumns.RoleID:
+		return &m.RoleID
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_userrole_query_builder) scanTargets(m *UserRole) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_userrole_query_builder) scanRow(row *sql.Row) (UserRole, error) {
+	var m UserRole
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return UserRole{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_userrole_query_builder) scanRows(rows *sql.Rows) ([]UserRole, error) {
+	var records []UserRole
+	for rows.Next() {
+		var m UserRole
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_userrole_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_userrole_query_builder) joinColumns(columns []UserRoleColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&UserRole{}, column) == nil {
+			return "", fmt.Errorf("unknown UserRole column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_userrole_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM user_roles", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_userrole_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE user_roles ")
+
+	var sets []string
+
+	if q.set.UserID.isSet {
+		rhs := q.set.UserID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.UserID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "user_id", rhs))
+	}
+
+	if q.set.RoleID.isSet {
+		rhs := q.set.RoleID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.RoleID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "role_id", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_userrole_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM user_roles")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_userrole_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_userrole_query_builder) WhereRaw(fragment string, args ...any) UserRoleQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from user_roles filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_userrole_query_builder) subquery(column UserRoleColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM user_roles" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_userrole_query_builder) Or(fn func(b UserRoleQueryBuilder)) UserRoleQueryBuilder {
+	group := &_dont_use_userrole_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_userrole_query_builder) And(fn func(b UserRoleQueryBuilder)) UserRoleQueryBuilder {
+	group := &_dont_use_userrole_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereUserIDGE(UserID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Ge, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereUserIDGT(UserID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Gt, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereUserIDLE(UserID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Le, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereUserIDLT(UserID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Lt, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereRoleIDGE(RoleID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role_id", Operator: qb.Ge, Argument: RoleID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereRoleIDGT(RoleID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role_id", Operator: qb.Gt, Argument: RoleID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereRoleIDLE(RoleID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role_id", Operator: qb.Le, Argument: RoleID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereRoleIDLT(RoleID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role_id", Operator: qb.Lt, Argument: RoleID})
+	return q
+}
+
+// WhereUserID compares user_id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_userrole_query_builder) WhereUserID(operator qb.Operator, UserID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: operator, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereUserIDIs(UserID int64) UserRoleQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Eq, Argument: UserID})
+	return q
+}
+
+// WhereUserIDIn matches rows whose user_id is one of UserIDs, an empty list matches nothing.
+func (q *_dont_use_userrole_query_builder) WhereUserIDIn(UserIDs ...int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.In, Arguments: qb.Args(UserIDs)})
+	return q
+}
+
+// WhereUserIDNotIn matches rows whose user_id is none of UserIDs, an empty list matches everything.
+func (q *_dont_use_userrole_query_builder) WhereUserIDNotIn(UserIDs ...int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.NotIn, Arguments: qb.Args(UserIDs)})
+	return q
+}
+
+// WhereRoleID compares role_id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_userrole_query_builder) WhereRoleID(operator qb.Operator, RoleID int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role_id", Operator: operator, Argument: RoleID})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) WhereRoleIDIs(RoleID int64) UserRoleQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "role_id", Operator: qb.Eq, Argument: RoleID})
+	return q
+}
+
+// WhereRoleIDIn matches rows whose role_id is one of RoleIDs, an empty list matches nothing.
+func (q *_dont_use_userrole_query_builder) WhereRoleIDIn(RoleIDs ...int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role_id", Operator: qb.In, Arguments: qb.Args(RoleIDs)})
+	return q
+}
+
+// WhereRoleIDNotIn matches rows whose role_id is none of RoleIDs, an empty list matches everything.
+func (q *_dont_use_userrole_query_builder) WhereRoleIDNotIn(RoleIDs ...int64) UserRoleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role_id", Operator: qb.NotIn, Arguments: qb.Args(RoleIDs)})
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) SetUserID(UserID int64) UserRoleQueryBuilder {
+	q.mode = "update"
+	q.set.UserID.argument = UserID
+	q.set.UserID.literal = ""
+	q.set.UserID.isSet = true
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) SetRoleID(RoleID int64) UserRoleQueryBuilder {
+	q.mode = "update"
+	q.set.RoleID.argument = RoleID
+	q.set.RoleID.literal = ""
+	q.set.RoleID.isSet = true
+	return q
+}
+
+func (q *_dont_use_userrole_query_builder) Add(ctx context.Context, db qb.Executor, record *UserRole) error {
+	query := "INSERT INTO user_roles (user_id, role_id) VALUES (?, ?)"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, record.UserID, record.RoleID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+func (q *_dont_use_userrole_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*UserRole) error {
+	const chunkSize = 65535 / 2
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_userrole_query_builder) addMany(ctx context.Context, db qb.Executor, records []*UserRole) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.UserID), q.bind(record.RoleID)}, ", ")+")")
+	}
+	query := "INSERT INTO user_roles (user_id, role_id) VALUES " + strings.Join(values, ", ")
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_userrole_query_builder) OnConflictUpdate(columns ...UserRoleColumn) UserRoleQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]UserRoleColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. MySQL picks the conflicting row by the
+// unique keys of the table, conflictColumns only excludes columns from the update.
+func (q *_dont_use_userrole_query_builder) Upsert(ctx context.Context, db qb.Executor, record *UserRole, conflictColumns ...UserRoleColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []UserRoleColumn{UserRoleColumns.UserID, UserRoleColumns.RoleID}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []UserRoleColumn{UserRoleColumns.UserID, UserRoleColumns.RoleID} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO user_roles (user_id, role_id) VALUES (" + strings.Join([]string{q.bind(record.UserID), q.bind(record.RoleID)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", column, column))
+	}
+
+	if len(sets) == 0 {
+		sets = append(sets, "user_id = user_id")
+	}
+	query += " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	return err
+
+}
+
 	
\ No newline at end of file
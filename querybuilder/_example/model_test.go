@@ -10,5 +10,5 @@ func TestUser(t *testing.T) {
 		ID:   1,
 		Name: "John Doe",
 	}
-	Users().Debug().Add(context.Background(), &user, nil)
+	Users().Debug().Add(context.Background(), nil, &user)
 }
@@ -0,0 +1,20 @@
+// Package legacy holds models generated with -legacy, the tests make sure the
+// context-free terminals still compile and run the statements they used to.
+package legacy
+
+//go:generate go run ../../.. -dialect postgres -legacy -file $GOFILE
+
+// @querybuilder
+type User struct {
+	ID    int64 `qb:"pk,autoincrement"`
+	Name  string
+	Posts []Post `qb:"has_many"`
+}
+
+// @querybuilder
+type Post struct {
+	ID     int64 `qb:"pk,autoincrement"`
+	UserID int64 `qb:"belongs_to=User"`
+	Title  string
+	User   *User `qb:"belongs_to"`
+}
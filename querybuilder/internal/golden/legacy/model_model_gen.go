@@ -0,0 +1,2942 @@
+// Code generated by modelgen. DO NOT EDIT
+
+package legacy
+
+import (
+    "fmt"
+    "strings"
+    "database/sql"
+	"context"
+	"iter"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+
+
+type UserQueryBuilder interface {
+	WhereIDIs(int64) UserQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) UserQueryBuilder
+	WhereIDIn(...int64) UserQueryBuilder
+	WhereIDNotIn(...int64) UserQueryBuilder
+
+	WhereIDGT(int64) UserQueryBuilder
+	WhereIDGE(int64) UserQueryBuilder
+	WhereIDLT(int64) UserQueryBuilder
+	WhereIDLE(int64) UserQueryBuilder
+
+	WhereNameIs(string) UserQueryBuilder
+	WhereName(operator qb.Operator, rhs string) UserQueryBuilder
+	WhereNameIn(...string) UserQueryBuilder
+	WhereNameNotIn(...string) UserQueryBuilder
+
+	WhereNameLike(pattern string) UserQueryBuilder
+	WhereNameILike(pattern string) UserQueryBuilder
+	WhereNameStartsWith(prefix string) UserQueryBuilder
+	WhereNameContains(substring string) UserQueryBuilder
+
+	WhereRaw(fragment string, args ...any) UserQueryBuilder
+
+	Or(func(b UserQueryBuilder)) UserQueryBuilder
+	And(func(b UserQueryBuilder)) UserQueryBuilder
+
+	WherePostsHas(func(b PostQueryBuilder)) UserQueryBuilder
+	PreloadPosts() UserQueryBuilder
+
+	OrderByAsc(column UserColumn) UserQueryBuilder
+	OrderByDesc(column UserColumn) UserQueryBuilder
+	OrderByRaw(fragment string, args ...any) UserQueryBuilder
+
+	Select(columns ...UserColumn) UserQueryBuilder
+	SelectRaw(fragment string, args ...any) UserQueryBuilder
+
+	GroupBy(columns ...UserColumn) UserQueryBuilder
+	Having(fragment string, args ...any) UserQueryBuilder
+	HavingCount(operator qb.Operator, count int64) UserQueryBuilder
+
+	After(cursor string) UserQueryBuilder
+	Before(cursor string) UserQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (UserCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserPage, error)
+
+	Limit(int) UserQueryBuilder
+	Offset(int) UserQueryBuilder
+
+	getPlaceholder() string
+
+	First(db qb.Executor) (User, error)
+	Last(db qb.Executor) (User, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (User, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+
+	SetID(int64) UserQueryBuilder
+
+	SetName(string) UserQueryBuilder
+
+	Add(ctx context.Context, record *User, db qb.Executor) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*User) error
+
+	OnConflictUpdate(columns ...UserColumn) UserQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *User, conflictColumns ...UserColumn) error
+
+	Update(db qb.Executor) (sql.Result, error)
+
+	Delete(db qb.Executor) (sql.Result, error)
+
+	Fetch(db qb.Executor) ([]User, error)
+	FindAll(db qb.Executor) ([]User, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[User, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]User) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]UserGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckName(ctx context.Context, db qb.Executor) ([]string, error)
+
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() UserQueryBuilder
+}
+
+type _dont_use_user_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Name struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []UserColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []UserColumn
+
+	selected  []UserColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []User) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Users() UserQueryBuilder {
+	return &_dont_use_user_query_builder{}
+}
+
+func (q *_dont_use_user_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type UserColumn string
+
+var UserColumns = struct {
+	ID   UserColumn
+	Name UserColumn
+}{
+	ID:   UserColumn("id"),
+	Name: UserColumn("name"),
+}
+
+func (q *_dont_use_user_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_user_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_user_query_builder) Limit(l int) UserQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_user_query_builder) Offset(l int) UserQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q User) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.Name)
+
+	return values
+}
+
+func (q *_dont_use_user_query_builder) Debug() UserQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// UsersFromRows scans every column of User from rows and closes them.
+func UsersFromRows(rows *sql.Rows) ([]User, error) {
+	defer rows.Close()
+	var Users []User
+	for rows.Next() {
+		var m User
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.Name,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Users = append(Users, m)
+	}
+	return Users, rows.Err()
+}
+
+func UserFromRow(row *sql.Row) (User, error) {
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	var q User
+	err := row.Scan(
+		&q.ID,
+		&q.Name,
+	)
+	if err != nil {
+		return User{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_user_query_builder) Update(db qb.Executor) (sql.Result, error) {
+	ctx := context.Background()
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) Delete(db qb.Executor) (sql.Result, error) {
+	ctx := context.Background()
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) Fetch(db qb.Executor) ([]User, error) {
+	ctx := context.Background()
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_user_query_builder) fetch(ctx context.Context, db qb.Executor) ([]User, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_user_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []User) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_user_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (User, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return User{}, err
+	}
+	records := []User{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return User{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_user_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(User{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m User
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(User{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(User{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_user_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]User) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(UserColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// UserPage is a page of Users returned by Paginate, Page counts from 1.
+type UserPage struct {
+	Items    []User
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_user_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_user_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserPage, error) {
+	if page < 1 || perPage < 1 {
+		return UserPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return UserPage{}, err
+	}
+	result := UserPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// UserCursorPage is a page of Users returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type UserCursorPage struct {
+	Items []User
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_user_query_builder) After(cursor string) UserQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_user_query_builder) Before(cursor string) UserQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_user_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []UserColumn{UserColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_user_query_builder) encodeCursor(record User, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, UserColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_user_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record User
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, UserColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown User column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_user_query_builder) Page(ctx context.Context, db qb.Executor, size int) (UserCursorPage, error) {
+	if size <= 0 {
+		return UserCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return UserCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return UserCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]UserColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = UserColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return UserCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return UserCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := UserCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return UserCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return UserCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_user_query_builder) FindAll(db qb.Executor) ([]User, error) {
+	return q.Fetch(db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_user_query_builder) GroupBy(columns ...UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_user_query_builder) Having(fragment string, args ...any) UserQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_user_query_builder) HavingCount(operator qb.Operator, count int64) UserQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// UserGroup is a group of Users returned by FetchGroups, only the
+// GroupBy fields of the embedded User are set.
+type UserGroup struct {
+	User
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_user_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]UserGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []UserGroup
+	for rows.Next() {
+		var g UserGroup
+		if err := rows.Scan(append(q.scanTargets(&g.User), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_user_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_user_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckName returns the name of every matching row.
+func (q *_dont_use_user_query_builder) PluckName(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Name}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_user_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM users" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_user_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_user_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM users" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
+}
+
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
+}
+
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
+}
+
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_user_query_builder) First(db qb.Executor) (User, error) {
+	ctx := context.Background()
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) Last(db qb.Executor) (User, error) {
+	ctx := context.Background()
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (User, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) OrderByAsc(column UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) OrderByDesc(column UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_user_query_builder) OrderByRaw(fragment string, args ...any) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of User in order, so they have to match them.
+func (q *_dont_use_user_query_builder) SelectRaw(fragment string, args ...any) UserQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Users keep their zero value.
+func (q *_dont_use_user_query_builder) Select(columns ...UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_user_query_builder) selectedColumns() []UserColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []UserColumn{UserColumns.ID, UserColumns.Name}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_user_query_builder) requireSelected(columns ...UserColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_user_query_builder) target(m *User, column UserColumn) interface{} {
+	switch column {
+	case UserColumns.ID:
+		return &m.ID
+	case UserColumns.Name:
+		return &m.Name
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_user_query_builder) scanTargets(m *User) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_user_query_builder) scanRow(row *sql.Row) (User, error) {
+	var m User
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return User{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_user_query_builder) scanRows(rows *sql.Rows) ([]User, error) {
+	var records []User
+	for rows.Next() {
+		var m User
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_user_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_user_query_builder) joinColumns(columns []UserColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&User{}, column) == nil {
+			return "", fmt.Errorf("unknown User column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_user_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM users", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_user_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE users ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.Name.isSet {
+		rhs := q.set.Name.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Name.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "name", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_user_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM users")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_user_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_user_query_builder) WhereRaw(fragment string, args ...any) UserQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from users filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_user_query_builder) subquery(column UserColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM users" + where, nil
+	}
+}
+
+// WherePostsHas keeps the Users with a Post matching the predicates fn adds.
+func (q *_dont_use_user_query_builder) WherePostsHas(fn func(b PostQueryBuilder)) UserQueryBuilder {
+	related := &_dont_use_post_query_builder{}
+	fn(related)
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Subquery: related.subquery(PostColumns.UserID)})
+	return q
+}
+
+// PreloadPosts loads the Posts of the rows Fetch, First, Last and FindBy return into their Posts
+// with one query, split when the keys exceed the bind parameter limit.
+func (q *_dont_use_user_query_builder) PreloadPosts() UserQueryBuilder {
+	q.preload = append(q.preload, q.preloadPosts)
+	return q
+}
+
+func (q *_dont_use_user_query_builder) preloadPosts(ctx context.Context, db qb.Executor, records []User) error {
+	seen := map[int64]bool{}
+	var keys []int64
+	for _, record := range records {
+		if key := record.ID; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	byKey := map[int64][]Post{}
+	for len(keys) > 0 {
+		n := min(len(keys), 65535)
+		related := &_dont_use_post_query_builder{}
+		related.where = append(related.where, qb.Predicate{Column: "user_id", Operator: qb.In, Arguments: qb.Args(keys[:n])})
+		rows, err := related.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			key := rows[i].UserID
+			byKey[key] = append(byKey[key], rows[i])
+		}
+		keys = keys[n:]
+	}
+	for i := range records {
+		records[i].Posts = byKey[records[i].ID]
+	}
+	return nil
+}
+
+// PostsQuery returns a query builder of the Posts of m.
+func (m User) PostsQuery() PostQueryBuilder {
+	return Posts().WhereUserIDIs(m.ID)
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_user_query_builder) Or(fn func(b UserQueryBuilder)) UserQueryBuilder {
+	group := &_dont_use_user_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_user_query_builder) And(fn func(b UserQueryBuilder)) UserQueryBuilder {
+	group := &_dont_use_user_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDGE(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDGT(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDLE(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDLT(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereID(operator qb.Operator, ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDIs(ID int64) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereIDIn(IDs ...int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereIDNotIn(IDs ...int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereName compares name using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereName(operator qb.Operator, Name string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: operator, Argument: Name})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNameIs(Name string) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Eq, Argument: Name})
+	return q
+}
+
+// WhereNameIn matches rows whose name is one of Names, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereNameIn(Names ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.In, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameNotIn matches rows whose name is none of Names, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereNameNotIn(Names ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.NotIn, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameLike matches name against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereNameLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNameILike matches name against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereNameILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereNameStartsWith matches rows whose name starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereNameStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNameContains matches rows whose name contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereNameContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetID(ID int64) UserQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetName(Name string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Name.argument = Name
+	q.set.Name.literal = ""
+	q.set.Name.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) Add(ctx context.Context, record *User, db qb.Executor) error {
+	query := "INSERT INTO users (name) VALUES ($1) RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return db.QueryRowContext(ctx, query, record.Name).Scan(&record.ID)
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from RETURNING assuming it yields the rows in the order
+// of VALUES, which postgres does in practice but doesn't document.
+func (q *_dont_use_user_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*User) error {
+	const chunkSize = 65535 / 1
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_user_query_builder) addMany(ctx context.Context, db qb.Executor, records []*User) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Name)}, ", ")+")")
+	}
+	query := "INSERT INTO users (name) VALUES " + strings.Join(values, ", ") + " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	// assumes the ids come back in the order of the VALUES rows, see AddMany.
+	n := 0
+	for ; rows.Next(); n++ {
+		if n < len(records) {
+			if err := rows.Scan(&records[n].ID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if n != len(records) {
+		return fmt.Errorf("insert of %d Users returned %d ids", len(records), n)
+	}
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_user_query_builder) OnConflictUpdate(columns ...UserColumn) UserQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]UserColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_user_query_builder) Upsert(ctx context.Context, db qb.Executor, record *User, conflictColumns ...UserColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []UserColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []UserColumn{UserColumns.Name} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO users (name) VALUES (" + strings.Join([]string{q.bind(record.Name)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of User needs conflictColumns to update the conflicting row, the primary key of User is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	query += " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.ID)
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+
+}
+
+
+
+
+type PostQueryBuilder interface {
+	WhereIDIs(int64) PostQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) PostQueryBuilder
+	WhereIDIn(...int64) PostQueryBuilder
+	WhereIDNotIn(...int64) PostQueryBuilder
+
+	WhereIDGT(int64) PostQueryBuilder
+	WhereIDGE(int64) PostQueryBuilder
+	WhereIDLT(int64) PostQueryBuilder
+	WhereIDLE(int64) PostQueryBuilder
+
+	WhereUserIDIs(int64) PostQueryBuilder
+	WhereUserID(operator qb.Operator, rhs int64) PostQueryBuilder
+	WhereUserIDIn(...int64) PostQueryBuilder
+	WhereUserIDNotIn(...int64) PostQueryBuilder
+
+	WhereUserIDGT(int64) PostQueryBuilder
+	WhereUserIDGE(int64) PostQueryBuilder
+	WhereUserIDLT(int64) PostQueryBuilder
+	WhereUserIDLE(int64) PostQueryBuilder
+
+	WhereTitleIs(string) PostQueryBuilder
+	WhereTitle(operator qb.Operator, rhs string) PostQueryBuilder
+	WhereTitleIn(...string) PostQueryBuilder
+	WhereTitleNotIn(...string) PostQueryBuilder
+
+	WhereTitleLike(pattern string) PostQueryBuilder
+	WhereTitleILike(pattern string) PostQueryBuilder
+	WhereTitleStartsWith(prefix string) PostQueryBuilder
+	WhereTitleContains(substring string) PostQueryBuilder
+
+	WhereRaw(fragment string, args ...any) PostQueryBuilder
+
+	Or(func(b PostQueryBuilder)) PostQueryBuilder
+	And(func(b PostQueryBuilder)) PostQueryBuilder
+
+	WhereUserHas(func(b UserQueryBuilder)) PostQueryBuilder
+	PreloadUser() PostQueryBuilder
+
+	OrderByAsc(column PostColumn) PostQueryBuilder
+	OrderByDesc(column PostColumn) PostQueryBuilder
+	OrderByRaw(fragment string, args ...any) PostQueryBuilder
+
+	Select(columns ...PostColumn) PostQueryBuilder
+	SelectRaw(fragment string, args ...any) PostQueryBuilder
+
+	GroupBy(columns ...PostColumn) PostQueryBuilder
+	Having(fragment string, args ...any) PostQueryBuilder
+	HavingCount(operator qb.Operator, count int64) PostQueryBuilder
+
+	After(cursor string) PostQueryBuilder
+	Before(cursor string) PostQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (PostCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (PostPage, error)
+
+	Limit(int) PostQueryBuilder
+	Offset(int) PostQueryBuilder
+
+	getPlaceholder() string
+
+	First(db qb.Executor) (Post, error)
+	Last(db qb.Executor) (Post, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (Post, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+
+	SetID(int64) PostQueryBuilder
+
+	SetUserID(int64) PostQueryBuilder
+
+	SetTitle(string) PostQueryBuilder
+
+	Add(ctx context.Context, record *Post, db qb.Executor) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Post) error
+
+	OnConflictUpdate(columns ...PostColumn) PostQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Post, conflictColumns ...PostColumn) error
+
+	Update(db qb.Executor) (sql.Result, error)
+
+	Delete(db qb.Executor) (sql.Result, error)
+
+	Fetch(db qb.Executor) ([]Post, error)
+	FindAll(db qb.Executor) ([]Post, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Post, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Post) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]PostGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckUserID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckTitle(ctx context.Context, db qb.Executor) ([]string, error)
+
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SumUserID(ctx context.Context, db qb.Executor) (int64, error)
+	MinUserID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxUserID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgUserID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() PostQueryBuilder
+}
+
+type _dont_use_post_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		UserID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Title struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []PostColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []PostColumn
+
+	selected  []PostColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Post) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Posts() PostQueryBuilder {
+	return &_dont_use_post_query_builder{}
+}
+
+func (q *_dont_use_post_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type PostColumn string
+
+var PostColumns = struct {
+	ID     PostColumn
+	UserID PostColumn
+	Title  PostColumn
+}{
+	ID:     PostColumn("id"),
+	UserID: PostColumn("user_id"),
+	Title:  PostColumn("title"),
+}
+
+func (q *_dont_use_post_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_post_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_post_query_builder) Limit(l int) PostQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_post_query_builder) Offset(l int) PostQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Post) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.UserID)
+	values = append(values, &q.Title)
+
+	return values
+}
+
+func (q *_dont_use_post_query_builder) Debug() PostQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// PostsFromRows scans every column of Post from rows and closes them.
+func PostsFromRows(rows *sql.Rows) ([]Post, error) {
+	defer rows.Close()
+	var Posts []Post
+	for rows.Next() {
+		var m Post
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.UserID,
+
+			&m.Title,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Posts = append(Posts, m)
+	}
+	return Posts, rows.Err()
+}
+
+func PostFromRow(row *sql.Row) (Post, error) {
+	if row.Err() != nil {
+		return Post{}, row.Err()
+	}
+	var q Post
+	err := row.Scan(
+		&q.ID,
+		&q.UserID,
+		&q.Title,
+	)
+	if err != nil {
+		return Post{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_post_query_builder) Update(db qb.Executor) (sql.Result, error) {
+	ctx := context.Background()
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_post_query_builder) Delete(db qb.Executor) (sql.Result, error) {
+	ctx := context.Background()
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_post_query_builder) Fetch(db qb.Executor) ([]Post, error) {
+	ctx := context.Background()
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_post_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Post, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_post_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Post) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_post_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Post, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Post{}, err
+	}
+	records := []Post{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Post{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_post_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Post, error] {
+	return func(yield func(Post, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Post{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Post
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Post{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Post{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_post_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Post) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(PostColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// PostPage is a page of Posts returned by Paginate, Page counts from 1.
+type PostPage struct {
+	Items    []Post
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_post_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_post_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (PostPage, error) {
+	if page < 1 || perPage < 1 {
+		return PostPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return PostPage{}, err
+	}
+	result := PostPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return PostPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// PostCursorPage is a page of Posts returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type PostCursorPage struct {
+	Items []Post
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_post_query_builder) After(cursor string) PostQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_post_query_builder) Before(cursor string) PostQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_post_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []PostColumn{PostColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_post_query_builder) encodeCursor(record Post, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, PostColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_post_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Post
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, PostColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Post column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_post_query_builder) Page(ctx context.Context, db qb.Executor, size int) (PostCursorPage, error) {
+	if size <= 0 {
+		return PostCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return PostCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return PostCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]PostColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = PostColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return PostCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return PostCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return PostCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := PostCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return PostCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return PostCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_post_query_builder) FindAll(db qb.Executor) ([]Post, error) {
+	return q.Fetch(db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_post_query_builder) GroupBy(columns ...PostColumn) PostQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_post_query_builder) Having(fragment string, args ...any) PostQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_post_query_builder) HavingCount(operator qb.Operator, count int64) PostQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// PostGroup is a group of Posts returned by FetchGroups, only the
+// GroupBy fields of the embedded Post are set.
+type PostGroup struct {
+	Post
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_post_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]PostGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []PostGroup
+	for rows.Next() {
+		var g PostGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Post), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_post_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_post_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []PostColumn{PostColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckUserID returns the user_id of every matching row.
+func (q *_dont_use_post_query_builder) PluckUserID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []PostColumn{PostColumns.UserID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckTitle returns the title of every matching row.
+func (q *_dont_use_post_query_builder) PluckTitle(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []PostColumn{PostColumns.Title}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_post_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM posts" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_post_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_post_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM posts" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_post_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
+}
+
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_post_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
+}
+
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_post_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
+}
+
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_post_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
+}
+
+// SumUserID returns the sum of user_id over the matching rows, 0 if there are none.
+func (q *_dont_use_post_query_builder) SumUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(user_id)", &sum)
+	return sum.V, err
+}
+
+// MinUserID returns the smallest user_id of the matching rows, 0 if there are none.
+func (q *_dont_use_post_query_builder) MinUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(user_id)", &min)
+	return min.V, err
+}
+
+// MaxUserID returns the largest user_id of the matching rows, 0 if there are none.
+func (q *_dont_use_post_query_builder) MaxUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(user_id)", &max)
+	return max.V, err
+}
+
+// AvgUserID returns the average of user_id over the matching rows, 0 if there are none.
+func (q *_dont_use_post_query_builder) AvgUserID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(user_id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_post_query_builder) First(db qb.Executor) (Post, error) {
+	ctx := context.Background()
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Post{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Post{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_post_query_builder) Last(db qb.Executor) (Post, error) {
+	ctx := context.Background()
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Post{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Post{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_post_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (Post, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Post{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Post{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_post_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_post_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_post_query_builder) OrderByAsc(column PostColumn) PostQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) OrderByDesc(column PostColumn) PostQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_post_query_builder) OrderByRaw(fragment string, args ...any) PostQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Post in order, so they have to match them.
+func (q *_dont_use_post_query_builder) SelectRaw(fragment string, args ...any) PostQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Posts keep their zero value.
+func (q *_dont_use_post_query_builder) Select(columns ...PostColumn) PostQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_post_query_builder) selectedColumns() []PostColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []PostColumn{PostColumns.ID, PostColumns.UserID, PostColumns.Title}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_post_query_builder) requireSelected(columns ...PostColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_post_query_builder) target(m *Post, column PostColumn) interface{} {
+	switch column {
+	case PostColumns.ID:
+		return &m.ID
+	case PostColumns.UserID:
+		return &m.UserID
+	case PostColumns.Title:
+		return &m.Title
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_post_query_builder) scanTargets(m *Post) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_post_query_builder) scanRow(row *sql.Row) (Post, error) {
+	var m Post
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Post{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_post_query_builder) scanRows(rows *sql.Rows) ([]Post, error) {
+	var records []Post
+	for rows.Next() {
+		var m Post
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_post_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_post_query_builder) joinColumns(columns []PostColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Post{}, column) == nil {
+			return "", fmt.Errorf("unknown Post column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_post_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM posts", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_post_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE posts ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.UserID.isSet {
+		rhs := q.set.UserID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.UserID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "user_id", rhs))
+	}
+
+	if q.set.Title.isSet {
+		rhs := q.set.Title.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Title.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "title", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_post_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM posts")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_post_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_post_query_builder) WhereRaw(fragment string, args ...any) PostQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from posts filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_post_query_builder) subquery(column PostColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM posts" + where, nil
+	}
+}
+
+// WhereUserHas keeps the Posts whose User is one matching the predicates fn adds.
+func (q *_dont_use_post_query_builder) WhereUserHas(fn func(b UserQueryBuilder)) PostQueryBuilder {
+	related := &_dont_use_user_query_builder{}
+	fn(related)
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.In, Subquery: related.subquery(UserColumns.ID)})
+	return q
+}
+
+// PreloadUser loads the User of the rows Fetch, First, Last and FindBy return into their User
+// with one query, split when the keys exceed the bind parameter limit.
+func (q *_dont_use_post_query_builder) PreloadUser() PostQueryBuilder {
+	q.preload = append(q.preload, q.preloadUser)
+	return q
+}
+
+func (q *_dont_use_post_query_builder) preloadUser(ctx context.Context, db qb.Executor, records []Post) error {
+	seen := map[int64]bool{}
+	var keys []int64
+	for _, record := range records {
+		if key := record.UserID; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	byKey := map[int64]*User{}
+	for len(keys) > 0 {
+		n := min(len(keys), 65535)
+		related := &_dont_use_user_query_builder{}
+		related.where = append(related.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(keys[:n])})
+		rows, err := related.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			key := rows[i].ID
+			byKey[key] = &rows[i]
+		}
+		keys = keys[n:]
+	}
+	for i := range records {
+		records[i].User = byKey[records[i].UserID]
+	}
+	return nil
+}
+
+// UserQuery returns a query builder of the User m belongs to.
+func (m Post) UserQuery() UserQueryBuilder {
+	return Users().WhereIDIs(m.UserID)
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_post_query_builder) Or(fn func(b PostQueryBuilder)) PostQueryBuilder {
+	group := &_dont_use_post_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_post_query_builder) And(fn func(b PostQueryBuilder)) PostQueryBuilder {
+	group := &_dont_use_post_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereIDGE(ID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereIDGT(ID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereIDLE(ID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereIDLT(ID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereUserIDGE(UserID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Ge, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereUserIDGT(UserID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Gt, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereUserIDLE(UserID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Le, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereUserIDLT(UserID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Lt, Argument: UserID})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_post_query_builder) WhereID(operator qb.Operator, ID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereIDIs(ID int64) PostQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_post_query_builder) WhereIDIn(IDs ...int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_post_query_builder) WhereIDNotIn(IDs ...int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereUserID compares user_id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_post_query_builder) WhereUserID(operator qb.Operator, UserID int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: operator, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereUserIDIs(UserID int64) PostQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Eq, Argument: UserID})
+	return q
+}
+
+// WhereUserIDIn matches rows whose user_id is one of UserIDs, an empty list matches nothing.
+func (q *_dont_use_post_query_builder) WhereUserIDIn(UserIDs ...int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.In, Arguments: qb.Args(UserIDs)})
+	return q
+}
+
+// WhereUserIDNotIn matches rows whose user_id is none of UserIDs, an empty list matches everything.
+func (q *_dont_use_post_query_builder) WhereUserIDNotIn(UserIDs ...int64) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.NotIn, Arguments: qb.Args(UserIDs)})
+	return q
+}
+
+// WhereTitle compares title using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_post_query_builder) WhereTitle(operator qb.Operator, Title string) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "title", Operator: operator, Argument: Title})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) WhereTitleIs(Title string) PostQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "title", Operator: qb.Eq, Argument: Title})
+	return q
+}
+
+// WhereTitleIn matches rows whose title is one of Titles, an empty list matches nothing.
+func (q *_dont_use_post_query_builder) WhereTitleIn(Titles ...string) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "title", Operator: qb.In, Arguments: qb.Args(Titles)})
+	return q
+}
+
+// WhereTitleNotIn matches rows whose title is none of Titles, an empty list matches everything.
+func (q *_dont_use_post_query_builder) WhereTitleNotIn(Titles ...string) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "title", Operator: qb.NotIn, Arguments: qb.Args(Titles)})
+	return q
+}
+
+// WhereTitleLike matches title against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_post_query_builder) WhereTitleLike(pattern string) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "title", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereTitleILike matches title against pattern ignoring case.
+func (q *_dont_use_post_query_builder) WhereTitleILike(pattern string) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "title", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereTitleStartsWith matches rows whose title starts with prefix, prefix is matched literally.
+func (q *_dont_use_post_query_builder) WhereTitleStartsWith(prefix string) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "title LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereTitleContains matches rows whose title contains substring, substring is matched literally.
+func (q *_dont_use_post_query_builder) WhereTitleContains(substring string) PostQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "title LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_post_query_builder) SetID(ID int64) PostQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_post_query_builder) SetUserID(UserID int64) PostQueryBuilder {
+	q.mode = "update"
+	q.set.UserID.argument = UserID
+	q.set.UserID.literal = ""
+	q.set.UserID.isSet = true
+	return q
+}
+
+func (q *_dont_use_post_query_builder) SetTitle(Title string) PostQueryBuilder {
+	q.mode = "update"
+	q.set.Title.argument = Title
+	q.set.Title.literal = ""
+	q.set.Title.isSet = true
+	return q
+}
+
+func (q *_dont_use_post_query_builder) Add(ctx context.Context, record *Post, db qb.Executor) error {
+	query := "INSERT INTO posts (user_id, title) VALUES ($1, $2) RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return db.QueryRowContext(ctx, query, record.UserID, record.Title).Scan(&record.ID)
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from RETURNING assuming it yields the rows in the order
+// of VALUES, which postgres does in practice but doesn't document.
+func (q *_dont_use_post_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Post) error {
+	const chunkSize = 65535 / 2
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_post_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Post) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.UserID), q.bind(record.Title)}, ", ")+")")
+	}
+	query := "INSERT INTO posts (user_id, title) VALUES " + strings.Join(values, ", ") + " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	// assumes the ids come back in the order of the VALUES rows, see AddMany.
+	n := 0
+	for ; rows.Next(); n++ {
+		if n < len(records) {
+			if err := rows.Scan(&records[n].ID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if n != len(records) {
+		return fmt.Errorf("insert of %d Posts returned %d ids", len(records), n)
+	}
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_post_query_builder) OnConflictUpdate(columns ...PostColumn) PostQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]PostColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_post_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Post, conflictColumns ...PostColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []PostColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []PostColumn{PostColumns.UserID, PostColumns.Title} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO posts (user_id, title) VALUES (" + strings.Join([]string{q.bind(record.UserID), q.bind(record.Title)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Post needs conflictColumns to update the conflicting row, the primary key of Post is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	query += " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.ID)
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+
+}
+
+	
\ No newline at end of file
@@ -0,0 +1,41 @@
+package legacy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/internal/golden/recorder"
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+func TestUserStatements(t *testing.T) {
+	recorder.CheckGolden(t, "user.golden", []recorder.Case{
+		{Name: "fetch", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").Fetch(db)
+		}},
+		{Name: "find all", Run: func(ctx context.Context, db qb.Executor) {
+			Users().FindAll(db)
+		}},
+		{Name: "first", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").First(db)
+		}},
+		{Name: "last", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Last(db)
+		}},
+		{Name: "update", Run: func(ctx context.Context, db qb.Executor) {
+			Users().SetName("jane").WhereIDIs(1).Update(db)
+		}},
+		{Name: "delete", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereIDIs(1).Delete(db)
+		}},
+		{Name: "add", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Add(ctx, &User{Name: "john"}, db)
+		}},
+		{Name: "count takes a context", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").Count(ctx, db)
+		}},
+		{Name: "fetch preloads", Run: func(ctx context.Context, db qb.Executor) {
+			Posts().PreloadUser().Fetch(db)
+		}},
+	})
+}
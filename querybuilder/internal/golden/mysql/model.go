@@ -0,0 +1,13 @@
+// Package mysql holds models generated with the mysql dialect, the tests compare
+// every statement the generated builders run against golden files.
+package mysql
+
+//go:generate go run ../../.. -dialect mysql -file $GOFILE
+
+// @querybuilder
+type User struct {
+	ID       int64 `qb:"pk,autoincrement"`
+	Name     string
+	Email    string `db:"email_address"`
+	Nickname *string
+}
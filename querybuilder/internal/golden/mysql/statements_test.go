@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/internal/golden/recorder"
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+func TestUserStatements(t *testing.T) {
+	recorder.CheckGolden(t, "user.golden", []recorder.Case{
+		{Name: "fetch where patterns", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameLike("jo%").WhereNameILike("JO%").WhereEmailStartsWith("john_").Fetch(ctx, db)
+		}},
+		{Name: "fetch where ilike operator", Run: func(ctx context.Context, db qb.Executor) {
+			nickname := "JJ%"
+			Users().WhereName(qb.ILike, "JO%").WhereNickname(qb.ILike, &nickname).WhereName(qb.Like, "jo%").Fetch(ctx, db)
+		}},
+		{Name: "upsert", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Upsert(ctx, db, &User{Name: "john", Email: "john@example.com"})
+		}},
+		{Name: "upsert on conflict update", Run: func(ctx context.Context, db qb.Executor) {
+			Users().OnConflictUpdate(UserColumns.Name).Upsert(ctx, db, &User{Name: "john", Email: "john@example.com"}, UserColumns.Email)
+		}},
+		{Name: "upsert on conflict do nothing", Run: func(ctx context.Context, db qb.Executor) {
+			Users().OnConflictUpdate().Upsert(ctx, db, &User{Name: "john", Email: "john@example.com"}, UserColumns.Email)
+		}},
+	})
+}
+
+func TestUpsertBackfillsID(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.LastInsertID = 42
+
+	user := User{Name: "john"}
+	if err := Users().Upsert(context.Background(), db, &user); err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != 42 {
+		t.Fatalf("got id %d, want the LAST_INSERT_ID 42", user.ID)
+	}
+}
+
+func TestAddManyBackfillsIDs(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.LastInsertID = 10
+
+	users := []*User{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	if err := Users().AddMany(context.Background(), db, users); err != nil {
+		t.Fatal(err)
+	}
+	for i, user := range users {
+		if user.ID != int64(10+i) {
+			t.Errorf("user %d got id %d, want %d", i, user.ID, 10+i)
+		}
+	}
+}
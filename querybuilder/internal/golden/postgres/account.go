@@ -0,0 +1,26 @@
+package postgres
+
+import "database/sql"
+
+//go:generate go run ../../.. -dialect postgres -file $GOFILE
+
+// @querybuilder
+type Account struct {
+	ID       int64 `qb:"pk,autoincrement"`
+	Name     string
+	Sent     []Transfer `qb:"has_many=FromID"`
+	Received []Transfer `qb:"has_many=ToID"`
+}
+
+// @querybuilder
+//
+// Transfer moves Amount between two accounts, FromID is NULL for deposits and
+// ToID for withdrawals.
+type Transfer struct {
+	ID     int64         `qb:"pk,autoincrement"`
+	FromID *int64        `qb:"belongs_to=Account"`
+	ToID   sql.NullInt64 `qb:"belongs_to=Account"`
+	Amount int64
+	From   *Account `qb:"belongs_to"`
+	To     *Account `qb:"belongs_to"`
+}
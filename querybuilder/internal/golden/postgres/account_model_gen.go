@@ -0,0 +1,3159 @@
+// Code generated by modelgen. DO NOT EDIT
+
+package postgres
+
+import (
+    "fmt"
+    "strings"
+    "database/sql"
+	"context"
+	"iter"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+
+
+type AccountQueryBuilder interface {
+	WhereIDIs(int64) AccountQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) AccountQueryBuilder
+	WhereIDIn(...int64) AccountQueryBuilder
+	WhereIDNotIn(...int64) AccountQueryBuilder
+
+	WhereIDGT(int64) AccountQueryBuilder
+	WhereIDGE(int64) AccountQueryBuilder
+	WhereIDLT(int64) AccountQueryBuilder
+	WhereIDLE(int64) AccountQueryBuilder
+
+	WhereNameIs(string) AccountQueryBuilder
+	WhereName(operator qb.Operator, rhs string) AccountQueryBuilder
+	WhereNameIn(...string) AccountQueryBuilder
+	WhereNameNotIn(...string) AccountQueryBuilder
+
+	WhereNameLike(pattern string) AccountQueryBuilder
+	WhereNameILike(pattern string) AccountQueryBuilder
+	WhereNameStartsWith(prefix string) AccountQueryBuilder
+	WhereNameContains(substring string) AccountQueryBuilder
+
+	WhereRaw(fragment string, args ...any) AccountQueryBuilder
+
+	Or(func(b AccountQueryBuilder)) AccountQueryBuilder
+	And(func(b AccountQueryBuilder)) AccountQueryBuilder
+
+	WhereSentHas(func(b TransferQueryBuilder)) AccountQueryBuilder
+	PreloadSent() AccountQueryBuilder
+	WhereReceivedHas(func(b TransferQueryBuilder)) AccountQueryBuilder
+	PreloadReceived() AccountQueryBuilder
+
+	OrderByAsc(column AccountColumn) AccountQueryBuilder
+	OrderByDesc(column AccountColumn) AccountQueryBuilder
+	OrderByRaw(fragment string, args ...any) AccountQueryBuilder
+
+	Select(columns ...AccountColumn) AccountQueryBuilder
+	SelectRaw(fragment string, args ...any) AccountQueryBuilder
+
+	GroupBy(columns ...AccountColumn) AccountQueryBuilder
+	Having(fragment string, args ...any) AccountQueryBuilder
+	HavingCount(operator qb.Operator, count int64) AccountQueryBuilder
+
+	After(cursor string) AccountQueryBuilder
+	Before(cursor string) AccountQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (AccountCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (AccountPage, error)
+
+	Limit(int) AccountQueryBuilder
+	Offset(int) AccountQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Account, error)
+	Last(ctx context.Context, db qb.Executor) (Account, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (Account, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+
+	SetID(int64) AccountQueryBuilder
+
+	SetName(string) AccountQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Account) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Account) error
+
+	OnConflictUpdate(columns ...AccountColumn) AccountQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Account, conflictColumns ...AccountColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Account, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Account, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Account, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Account) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]AccountGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckName(ctx context.Context, db qb.Executor) ([]string, error)
+
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() AccountQueryBuilder
+}
+
+type _dont_use_account_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Name struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []AccountColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []AccountColumn
+
+	selected  []AccountColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Account) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Accounts() AccountQueryBuilder {
+	return &_dont_use_account_query_builder{}
+}
+
+func (q *_dont_use_account_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type AccountColumn string
+
+var AccountColumns = struct {
+	ID   AccountColumn
+	Name AccountColumn
+}{
+	ID:   AccountColumn("id"),
+	Name: AccountColumn("name"),
+}
+
+func (q *_dont_use_account_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_account_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_account_query_builder) Limit(l int) AccountQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_account_query_builder) Offset(l int) AccountQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Account) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.Name)
+
+	return values
+}
+
+func (q *_dont_use_account_query_builder) Debug() AccountQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// AccountsFromRows scans every column of Account from rows and closes them.
+func AccountsFromRows(rows *sql.Rows) ([]Account, error) {
+	defer rows.Close()
+	var Accounts []Account
+	for rows.Next() {
+		var m Account
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.Name,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Accounts = append(Accounts, m)
+	}
+	return Accounts, rows.Err()
+}
+
+func AccountFromRow(row *sql.Row) (Account, error) {
+	if row.Err() != nil {
+		return Account{}, row.Err()
+	}
+	var q Account
+	err := row.Scan(
+		&q.ID,
+		&q.Name,
+	)
+	if err != nil {
+		return Account{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_account_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_account_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_account_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Account, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_account_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Account, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_account_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Account) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_account_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Account, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Account{}, err
+	}
+	records := []Account{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Account{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_account_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Account, error] {
+	return func(yield func(Account, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Account{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Account
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Account{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Account{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_account_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Account) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(AccountColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// AccountPage is a page of Accounts returned by Paginate, Page counts from 1.
+type AccountPage struct {
+	Items    []Account
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_account_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_account_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (AccountPage, error) {
+	if page < 1 || perPage < 1 {
+		return AccountPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return AccountPage{}, err
+	}
+	result := AccountPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return AccountPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// AccountCursorPage is a page of Accounts returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type AccountCursorPage struct {
+	Items []Account
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_account_query_builder) After(cursor string) AccountQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_account_query_builder) Before(cursor string) AccountQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_account_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []AccountColumn{AccountColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_account_query_builder) encodeCursor(record Account, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, AccountColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_account_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Account
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, AccountColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Account column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_account_query_builder) Page(ctx context.Context, db qb.Executor, size int) (AccountCursorPage, error) {
+	if size <= 0 {
+		return AccountCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return AccountCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return AccountCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]AccountColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = AccountColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return AccountCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return AccountCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return AccountCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := AccountCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return AccountCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return AccountCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_account_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Account, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_account_query_builder) GroupBy(columns ...AccountColumn) AccountQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_account_query_builder) Having(fragment string, args ...any) AccountQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_account_query_builder) HavingCount(operator qb.Operator, count int64) AccountQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// AccountGroup is a group of Accounts returned by FetchGroups, only the
+// GroupBy fields of the embedded Account are set.
+type AccountGroup struct {
+	Account
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_account_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]AccountGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []AccountGroup
+	for rows.Next() {
+		var g AccountGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Account), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_account_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_account_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []AccountColumn{AccountColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckName returns the name of every matching row.
+func (q *_dont_use_account_query_builder) PluckName(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []AccountColumn{AccountColumns.Name}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_account_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM accounts" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_account_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_account_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM accounts" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_account_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
+}
+
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_account_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
+}
+
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_account_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
+}
+
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_account_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_account_query_builder) First(ctx context.Context, db qb.Executor) (Account, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Account{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Account{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_account_query_builder) Last(ctx context.Context, db qb.Executor) (Account, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Account{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Account{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_account_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (Account, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Account{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Account{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_account_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_account_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_account_query_builder) OrderByAsc(column AccountColumn) AccountQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_account_query_builder) OrderByDesc(column AccountColumn) AccountQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_account_query_builder) OrderByRaw(fragment string, args ...any) AccountQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Account in order, so they have to match them.
+func (q *_dont_use_account_query_builder) SelectRaw(fragment string, args ...any) AccountQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Accounts keep their zero value.
+func (q *_dont_use_account_query_builder) Select(columns ...AccountColumn) AccountQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_account_query_builder) selectedColumns() []AccountColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []AccountColumn{AccountColumns.ID, AccountColumns.Name}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_account_query_builder) requireSelected(columns ...AccountColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_account_query_builder) target(m *Account, column AccountColumn) interface{} {
+	switch column {
+	case AccountColumns.ID:
+		return &m.ID
+	case AccountColumns.Name:
+		return &m.Name
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_account_query_builder) scanTargets(m *Account) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_account_query_builder) scanRow(row *sql.Row) (Account, error) {
+	var m Account
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Account{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_account_query_builder) scanRows(rows *sql.Rows) ([]Account, error) {
+	var records []Account
+	for rows.Next() {
+		var m Account
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_account_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_account_query_builder) joinColumns(columns []AccountColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Account{}, column) == nil {
+			return "", fmt.Errorf("unknown Account column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_account_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM accounts", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_account_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE accounts ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.Name.isSet {
+		rhs := q.set.Name.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Name.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "name", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_account_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM accounts")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_account_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_account_query_builder) WhereRaw(fragment string, args ...any) AccountQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from accounts filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_account_query_builder) subquery(column AccountColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM accounts" + where, nil
+	}
+}
+
+// WhereSentHas keeps the Accounts with a Transfer matching the predicates fn adds.
+func (q *_dont_use_account_query_builder) WhereSentHas(fn func(b TransferQueryBuilder)) AccountQueryBuilder {
+	related := &_dont_use_transfer_query_builder{}
+	fn(related)
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Subquery: related.subquery(TransferColumns.FromID)})
+	return q
+}
+
+// PreloadSent loads the Transfers of the rows Fetch, First, Last and FindBy return into their Sent
+// with one query, split when the keys exceed the bind parameter limit.
+func (q *_dont_use_account_query_builder) PreloadSent() AccountQueryBuilder {
+	q.preload = append(q.preload, q.preloadSent)
+	return q
+}
+
+func (q *_dont_use_account_query_builder) preloadSent(ctx context.Context, db qb.Executor, records []Account) error {
+	seen := map[int64]bool{}
+	var keys []int64
+	for _, record := range records {
+		if key := record.ID; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	byKey := map[int64][]Transfer{}
+	for len(keys) > 0 {
+		n := min(len(keys), 65535)
+		related := &_dont_use_transfer_query_builder{}
+		related.where = append(related.where, qb.Predicate{Column: "from_id", Operator: qb.In, Arguments: qb.Args(keys[:n])})
+		rows, err := related.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			if rows[i].FromID == nil {
+				continue
+			}
+			key := *rows[i].FromID
+			byKey[key] = append(byKey[key], rows[i])
+		}
+		keys = keys[n:]
+	}
+	for i := range records {
+		records[i].Sent = byKey[records[i].ID]
+	}
+	return nil
+}
+
+// SentQuery returns a query builder of the Transfers of m.
+func (m Account) SentQuery() TransferQueryBuilder {
+	return Transfers().WhereFromIDIs(&m.ID)
+}
+
+// WhereReceivedHas keeps the Accounts with a Transfer matching the predicates fn adds.
+func (q *_dont_use_account_query_builder) WhereReceivedHas(fn func(b TransferQueryBuilder)) AccountQueryBuilder {
+	related := &_dont_use_transfer_query_builder{}
+	fn(related)
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Subquery: related.subquery(TransferColumns.ToID)})
+	return q
+}
+
+// PreloadReceived loads the Transfers of the rows Fetch, First, Last and FindBy return into their Received
+// with one query, split when the keys exceed the bind parameter limit.
+func (q *_dont_use_account_query_builder) PreloadReceived() AccountQueryBuilder {
+	q.preload = append(q.preload, q.preloadReceived)
+	return q
+}
+
+func (q *_dont_use_account_query_builder) preloadReceived(ctx context.Context, db qb.Executor, records []Account) error {
+	seen := map[int64]bool{}
+	var keys []int64
+	for _, record := range records {
+		if key := record.ID; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	byKey := map[int64][]Transfer{}
+	for len(keys) > 0 {
+		n := min(len(keys), 65535)
+		related := &_dont_use_transfer_query_builder{}
+		related.where = append(related.where, qb.Predicate{Column: "to_id", Operator: qb.In, Arguments: qb.Args(keys[:n])})
+		rows, err := related.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			if !rows[i].ToID.Valid {
+				continue
+			}
+			key := rows[i].ToID.Int64
+			byKey[key] = append(byKey[key], rows[i])
+		}
+		keys = keys[n:]
+	}
+	for i := range records {
+		records[i].Received = byKey[records[i].ID]
+	}
+	return nil
+}
+
+// ReceivedQuery returns a query builder of the Transfers of m.
+func (m Account) ReceivedQuery() TransferQueryBuilder {
+	return Transfers().WhereToIDIs(sql.NullInt64{Int64: m.ID, Valid: true})
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_account_query_builder) Or(fn func(b AccountQueryBuilder)) AccountQueryBuilder {
+	group := &_dont_use_account_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_account_query_builder) And(fn func(b AccountQueryBuilder)) AccountQueryBuilder {
+	group := &_dont_use_account_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_account_query_builder) WhereIDGE(ID int64) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_account_query_builder) WhereIDGT(ID int64) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_account_query_builder) WhereIDLE(ID int64) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_account_query_builder) WhereIDLT(ID int64) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_account_query_builder) WhereID(operator qb.Operator, ID int64) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_account_query_builder) WhereIDIs(ID int64) AccountQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_account_query_builder) WhereIDIn(IDs ...int64) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_account_query_builder) WhereIDNotIn(IDs ...int64) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereName compares name using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_account_query_builder) WhereName(operator qb.Operator, Name string) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: operator, Argument: Name})
+	return q
+}
+
+func (q *_dont_use_account_query_builder) WhereNameIs(Name string) AccountQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Eq, Argument: Name})
+	return q
+}
+
+// WhereNameIn matches rows whose name is one of Names, an empty list matches nothing.
+func (q *_dont_use_account_query_builder) WhereNameIn(Names ...string) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.In, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameNotIn matches rows whose name is none of Names, an empty list matches everything.
+func (q *_dont_use_account_query_builder) WhereNameNotIn(Names ...string) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.NotIn, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameLike matches name against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_account_query_builder) WhereNameLike(pattern string) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNameILike matches name against pattern ignoring case.
+func (q *_dont_use_account_query_builder) WhereNameILike(pattern string) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereNameStartsWith matches rows whose name starts with prefix, prefix is matched literally.
+func (q *_dont_use_account_query_builder) WhereNameStartsWith(prefix string) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNameContains matches rows whose name contains substring, substring is matched literally.
+func (q *_dont_use_account_query_builder) WhereNameContains(substring string) AccountQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_account_query_builder) SetID(ID int64) AccountQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_account_query_builder) SetName(Name string) AccountQueryBuilder {
+	q.mode = "update"
+	q.set.Name.argument = Name
+	q.set.Name.literal = ""
+	q.set.Name.isSet = true
+	return q
+}
+
+func (q *_dont_use_account_query_builder) Add(ctx context.Context, db qb.Executor, record *Account) error {
+	query := "INSERT INTO accounts (name) VALUES ($1) RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return db.QueryRowContext(ctx, query, record.Name).Scan(&record.ID)
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from RETURNING assuming it yields the rows in the order
+// of VALUES, which postgres does in practice but doesn't document.
+func (q *_dont_use_account_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Account) error {
+	const chunkSize = 65535 / 1
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_account_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Account) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Name)}, ", ")+")")
+	}
+	query := "INSERT INTO accounts (name) VALUES " + strings.Join(values, ", ") + " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	// assumes the ids come back in the order of the VALUES rows, see AddMany.
+	n := 0
+	for ; rows.Next(); n++ {
+		if n < len(records) {
+			if err := rows.Scan(&records[n].ID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if n != len(records) {
+		return fmt.Errorf("insert of %d Accounts returned %d ids", len(records), n)
+	}
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_account_query_builder) OnConflictUpdate(columns ...AccountColumn) AccountQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]AccountColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_account_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Account, conflictColumns ...AccountColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []AccountColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []AccountColumn{AccountColumns.Name} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO accounts (name) VALUES (" + strings.Join([]string{q.bind(record.Name)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Account needs conflictColumns to update the conflicting row, the primary key of Account is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	query += " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.ID)
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+
+}
+
+
+
+
+type TransferQueryBuilder interface {
+	WhereIDIs(int64) TransferQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) TransferQueryBuilder
+	WhereIDIn(...int64) TransferQueryBuilder
+	WhereIDNotIn(...int64) TransferQueryBuilder
+
+	WhereIDGT(int64) TransferQueryBuilder
+	WhereIDGE(int64) TransferQueryBuilder
+	WhereIDLT(int64) TransferQueryBuilder
+	WhereIDLE(int64) TransferQueryBuilder
+
+	WhereFromIDIs(*int64) TransferQueryBuilder
+	WhereFromID(operator qb.Operator, rhs *int64) TransferQueryBuilder
+	WhereFromIDIn(...*int64) TransferQueryBuilder
+	WhereFromIDNotIn(...*int64) TransferQueryBuilder
+
+	WhereFromIDIsNull() TransferQueryBuilder
+	WhereFromIDIsNotNull() TransferQueryBuilder
+
+	WhereToIDIs(sql.NullInt64) TransferQueryBuilder
+	WhereToID(operator qb.Operator, rhs sql.NullInt64) TransferQueryBuilder
+	WhereToIDIn(...sql.NullInt64) TransferQueryBuilder
+	WhereToIDNotIn(...sql.NullInt64) TransferQueryBuilder
+
+	WhereToIDIsNull() TransferQueryBuilder
+	WhereToIDIsNotNull() TransferQueryBuilder
+
+	WhereAmountIs(int64) TransferQueryBuilder
+	WhereAmount(operator qb.Operator, rhs int64) TransferQueryBuilder
+	WhereAmountIn(...int64) TransferQueryBuilder
+	WhereAmountNotIn(...int64) TransferQueryBuilder
+
+	WhereAmountGT(int64) TransferQueryBuilder
+	WhereAmountGE(int64) TransferQueryBuilder
+	WhereAmountLT(int64) TransferQueryBuilder
+	WhereAmountLE(int64) TransferQueryBuilder
+
+	WhereRaw(fragment string, args ...any) TransferQueryBuilder
+
+	Or(func(b TransferQueryBuilder)) TransferQueryBuilder
+	And(func(b TransferQueryBuilder)) TransferQueryBuilder
+
+	WhereFromHas(func(b AccountQueryBuilder)) TransferQueryBuilder
+	PreloadFrom() TransferQueryBuilder
+	WhereToHas(func(b AccountQueryBuilder)) TransferQueryBuilder
+	PreloadTo() TransferQueryBuilder
+
+	OrderByAsc(column TransferColumn) TransferQueryBuilder
+	OrderByDesc(column TransferColumn) TransferQueryBuilder
+	OrderByRaw(fragment string, args ...any) TransferQueryBuilder
+
+	Select(columns ...TransferColumn) TransferQueryBuilder
+	SelectRaw(fragment string, args ...any) TransferQueryBuilder
+
+	GroupBy(columns ...TransferColumn) TransferQueryBuilder
+	Having(fragment string, args ...any) TransferQueryBuilder
+	HavingCount(operator qb.Operator, count int64) TransferQueryBuilder
+
+	After(cursor string) TransferQueryBuilder
+	Before(cursor string) TransferQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (TransferCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (TransferPage, error)
+
+	Limit(int) TransferQueryBuilder
+	Offset(int) TransferQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Transfer, error)
+	Last(ctx context.Context, db qb.Executor) (Transfer, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (Transfer, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+
+	SetID(int64) TransferQueryBuilder
+
+	SetFromID(*int64) TransferQueryBuilder
+	SetFromIDNull() TransferQueryBuilder
+
+	SetToID(sql.NullInt64) TransferQueryBuilder
+	SetToIDNull() TransferQueryBuilder
+
+	SetAmount(int64) TransferQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Transfer) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Transfer) error
+
+	OnConflictUpdate(columns ...TransferColumn) TransferQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Transfer, conflictColumns ...TransferColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Transfer, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Transfer, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Transfer, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Transfer) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]TransferGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckFromID(ctx context.Context, db qb.Executor) ([]*int64, error)
+	PluckToID(ctx context.Context, db qb.Executor) ([]sql.NullInt64, error)
+	PluckAmount(ctx context.Context, db qb.Executor) ([]int64, error)
+
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SumAmount(ctx context.Context, db qb.Executor) (int64, error)
+	MinAmount(ctx context.Context, db qb.Executor) (int64, error)
+	MaxAmount(ctx context.Context, db qb.Executor) (int64, error)
+	AvgAmount(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() TransferQueryBuilder
+}
+
+type _dont_use_transfer_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		FromID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		ToID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Amount struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []TransferColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []TransferColumn
+
+	selected  []TransferColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Transfer) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Transfers() TransferQueryBuilder {
+	return &_dont_use_transfer_query_builder{}
+}
+
+func (q *_dont_use_transfer_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type TransferColumn string
+
+var TransferColumns = struct {
+	ID     TransferColumn
+	FromID TransferColumn
+	ToID   TransferColumn
+	Amount TransferColumn
+}{
+	ID:     TransferColumn("id"),
+	FromID: TransferColumn("from_id"),
+	ToID:   TransferColumn("to_id"),
+	Amount: TransferColumn("amount"),
+}
+
+func (q *_dont_use_transfer_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_transfer_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_transfer_query_builder) Limit(l int) TransferQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) Offset(l int) TransferQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Transfer) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.FromID)
+	values = append(values, &q.ToID)
+	values = append(values, &q.Amount)
+
+	return values
+}
+
+func (q *_dont_use_transfer_query_builder) Debug() TransferQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// TransfersFromRows scans every column of Transfer from rows and closes them.
+func TransfersFromRows(rows *sql.Rows) ([]Transfer, error) {
+	defer rows.Close()
+	var Transfers []Transfer
+	for rows.Next() {
+		var m Transfer
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.FromID,
+
+			&m.ToID,
+
+			&m.Amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Transfers = append(Transfers, m)
+	}
+	return Transfers, rows.Err()
+}
+
+func TransferFromRow(row *sql.Row) (Transfer, error) {
+	if row.Err() != nil {
+		return Transfer{}, row.Err()
+	}
+	var q Transfer
+	err := row.Scan(
+		&q.ID,
+		&q.FromID,
+		&q.ToID,
+		&q.Amount,
+	)
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_transfer_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_transfer_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_transfer_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Transfer, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_transfer_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Transfer, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_transfer_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Transfer) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_transfer_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Transfer, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Transfer{}, err
+	}
+	records := []Transfer{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Transfer{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_transfer_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Transfer, error] {
+	return func(yield func(Transfer, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Transfer{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Transfer
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Transfer{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Transfer{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_transfer_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Transfer) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(TransferColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// TransferPage is a page of Transfers returned by Paginate, Page counts from 1.
+type TransferPage struct {
+	Items    []Transfer
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_transfer_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_transfer_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (TransferPage, error) {
+	if page < 1 || perPage < 1 {
+		return TransferPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return TransferPage{}, err
+	}
+	result := TransferPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return TransferPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// TransferCursorPage is a page of Transfers returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type TransferCursorPage struct {
+	Items []Transfer
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_transfer_query_builder) After(cursor string) TransferQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_transfer_query_builder) Before(cursor string) TransferQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_transfer_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []TransferColumn{TransferColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_transfer_query_builder) encodeCursor(record Transfer, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, TransferColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_transfer_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Transfer
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, TransferColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Transfer column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_transfer_query_builder) Page(ctx context.Context, db qb.Executor, size int) (TransferCursorPage, error) {
+	if size <= 0 {
+		return TransferCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return TransferCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return TransferCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]TransferColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = TransferColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return TransferCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return TransferCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return TransferCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := TransferCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return TransferCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return TransferCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_transfer_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Transfer, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_transfer_query_builder) GroupBy(columns ...TransferColumn) TransferQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_transfer_query_builder) Having(fragment string, args ...any) TransferQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_transfer_query_builder) HavingCount(operator qb.Operator, count int64) TransferQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// TransferGroup is a group of Transfers returned by FetchGroups, only the
+// GroupBy fields of the embedded Transfer are set.
+type TransferGroup struct {
+	Transfer
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_transfer_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]TransferGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []TransferGroup
+	for rows.Next() {
+		var g TransferGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Transfer), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_transfer_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_transfer_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []TransferColumn{TransferColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckFromID returns the from_id of every matching row.
+func (q *_dont_use_transfer_query_builder) PluckFromID(ctx context.Context, db qb.Executor) ([]*int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []TransferColumn{TransferColumns.FromID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v **int64) []any { return []any{v} })
+}
+
+// PluckToID returns the to_id of every matching row.
+func (q *_dont_use_transfer_query_builder) PluckToID(ctx context.Context, db qb.Executor) ([]sql.NullInt64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []TransferColumn{TransferColumns.ToID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *sql.NullInt64) []any { return []any{v} })
+}
+
+// PluckAmount returns the amount of every matching row.
+func (q *_dont_use_transfer_query_builder) PluckAmount(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []TransferColumn{TransferColumns.Amount}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_transfer_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM transfers" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_transfer_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_transfer_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM transfers" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_transfer_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
+}
+
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_transfer_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
+}
+
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_transfer_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
+}
+
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_transfer_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
+}
+
+// SumAmount returns the sum of amount over the matching rows, 0 if there are none.
+func (q *_dont_use_transfer_query_builder) SumAmount(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(amount)", &sum)
+	return sum.V, err
+}
+
+// MinAmount returns the smallest amount of the matching rows, 0 if there are none.
+func (q *_dont_use_transfer_query_builder) MinAmount(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(amount)", &min)
+	return min.V, err
+}
+
+// MaxAmount returns the largest amount of the matching rows, 0 if there are none.
+func (q *_dont_use_transfer_query_builder) MaxAmount(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(amount)", &max)
+	return max.V, err
+}
+
+// AvgAmount returns the average of amount over the matching rows, 0 if there are none.
+func (q *_dont_use_transfer_query_builder) AvgAmount(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(amount)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_transfer_query_builder) First(ctx context.Context, db qb.Executor) (Transfer, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Transfer{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Transfer{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_transfer_query_builder) Last(ctx context.Context, db qb.Executor) (Transfer, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Transfer{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Transfer{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_transfer_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (Transfer, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Transfer{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Transfer{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_transfer_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_transfer_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_transfer_query_builder) OrderByAsc(column TransferColumn) TransferQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) OrderByDesc(column TransferColumn) TransferQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_transfer_query_builder) OrderByRaw(fragment string, args ...any) TransferQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Transfer in order, so they have to match them.
+func (q *_dont_use_transfer_query_builder) SelectRaw(fragment string, args ...any) TransferQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Transfers keep their zero value.
+func (q *_dont_use_transfer_query_builder) Select(columns ...TransferColumn) TransferQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) selectedColumns() []TransferColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []TransferColumn{TransferColumns.ID, TransferColumns.FromID, TransferColumns.ToID, TransferColumns.Amount}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_transfer_query_builder) requireSelected(columns ...TransferColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_transfer_query_builder) target(m *Transfer, column TransferColumn) interface{} {
+	switch column {
+	case TransferColumns.ID:
+		return &m.ID
+	case TransferColumns.FromID:
+		return &m.FromID
+	case TransferColumns.ToID:
+		return &m.ToID
+	case TransferColumns.Amount:
+		return &m.Amount
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_transfer_query_builder) scanTargets(m *Transfer) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_transfer_query_builder) scanRow(row *sql.Row) (Transfer, error) {
+	var m Transfer
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Transfer{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_transfer_query_builder) scanRows(rows *sql.Rows) ([]Transfer, error) {
+	var records []Transfer
+	for rows.Next() {
+		var m Transfer
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_transfer_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_transfer_query_builder) joinColumns(columns []TransferColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Transfer{}, column) == nil {
+			return "", fmt.Errorf("unknown Transfer column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_transfer_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM transfers", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_transfer_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE transfers ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.FromID.isSet {
+		rhs := q.set.FromID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.FromID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "from_id", rhs))
+	}
+
+	if q.set.ToID.isSet {
+		rhs := q.set.ToID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ToID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "to_id", rhs))
+	}
+
+	if q.set.Amount.isSet {
+		rhs := q.set.Amount.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Amount.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "amount", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_transfer_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM transfers")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_transfer_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_transfer_query_builder) WhereRaw(fragment string, args ...any) TransferQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from transfers filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_transfer_query_builder) subquery(column TransferColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM transfers" + where, nil
+	}
+}
+
+// WhereFromHas keeps the Transfers whose Account is one matching the predicates fn adds.
+func (q *_dont_use_transfer_query_builder) WhereFromHas(fn func(b AccountQueryBuilder)) TransferQueryBuilder {
+	related := &_dont_use_account_query_builder{}
+	fn(related)
+	q.where = append(q.where, qb.Predicate{Column: "from_id", Operator: qb.In, Subquery: related.subquery(AccountColumns.ID)})
+	return q
+}
+
+// PreloadFrom loads the Account of the rows Fetch, First, Last and FindBy return into their From
+// with one query, split when the keys exceed the bind parameter limit.
+func (q *_dont_use_transfer_query_builder) PreloadFrom() TransferQueryBuilder {
+	q.preload = append(q.preload, q.preloadFrom)
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) preloadFrom(ctx context.Context, db qb.Executor, records []Transfer) error {
+	seen := map[int64]bool{}
+	var keys []int64
+	for _, record := range records {
+		if record.FromID == nil {
+			continue
+		}
+		if key := *record.FromID; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	byKey := map[int64]*Account{}
+	for len(keys) > 0 {
+		n := min(len(keys), 65535)
+		related := &_dont_use_account_query_builder{}
+		related.where = append(related.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(keys[:n])})
+		rows, err := related.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			key := rows[i].ID
+			byKey[key] = &rows[i]
+		}
+		keys = keys[n:]
+	}
+	for i := range records {
+		if records[i].FromID == nil {
+			continue
+		}
+		records[i].From = byKey[*records[i].FromID]
+	}
+	return nil
+}
+
+// FromQuery returns a query builder of the Account m belongs to.
+func (m Transfer) FromQuery() AccountQueryBuilder {
+	if m.FromID == nil {
+		// a NULL FromID references no Account, an empty list matches nothing.
+		return Accounts().WhereIDIn()
+	}
+	return Accounts().WhereIDIs(*m.FromID)
+}
+
+// WhereToHas keeps the Transfers whose Account is one matching the predicates fn adds.
+func (q *_dont_use_transfer_query_builder) WhereToHas(fn func(b AccountQueryBuilder)) TransferQueryBuilder {
+	related := &_dont_use_account_query_builder{}
+	fn(related)
+	q.where = append(q.where, qb.Predicate{Column: "to_id", Operator: qb.In, Subquery: related.subquery(AccountColumns.ID)})
+	return q
+}
+
+// PreloadTo loads the Account of the rows Fetch, First, Last and FindBy return into their To
+// with one query, split when the keys exceed the bind parameter limit.
+func (q *_dont_use_transfer_query_builder) PreloadTo() TransferQueryBuilder {
+	q.preload = append(q.preload, q.preloadTo)
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) preloadTo(ctx context.Context, db qb.Executor, records []Transfer) error {
+	seen := map[int64]bool{}
+	var keys []int64
+	for _, record := range records {
+		if !record.ToID.Valid {
+			continue
+		}
+		if key := record.ToID.Int64; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	byKey := map[int64]*Account{}
+	for len(keys) > 0 {
+		n := min(len(keys), 65535)
+		related := &_dont_use_account_query_builder{}
+		related.where = append(related.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(keys[:n])})
+		rows, err := related.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			key := rows[i].ID
+			byKey[key] = &rows[i]
+		}
+		keys = keys[n:]
+	}
+	for i := range records {
+		if !records[i].ToID.Valid {
+			continue
+		}
+		records[i].To = byKey[records[i].ToID.Int64]
+	}
+	return nil
+}
+
+// ToQuery returns a query builder of the Account m belongs to.
+func (m Transfer) ToQuery() AccountQueryBuilder {
+	if !m.ToID.Valid {
+		// a NULL ToID references no Account, an empty list matches nothing.
+		return Accounts().WhereIDIn()
+	}
+	return Accounts().WhereIDIs(m.ToID.Int64)
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_transfer_query_builder) Or(fn func(b TransferQueryBuilder)) TransferQueryBuilder {
+	group := &_dont_use_transfer_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_transfer_query_builder) And(fn func(b TransferQueryBuilder)) TransferQueryBuilder {
+	group := &_dont_use_transfer_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereIDGE(ID int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereIDGT(ID int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereIDLE(ID int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereIDLT(ID int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereAmountGE(Amount int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "amount", Operator: qb.Ge, Argument: Amount})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereAmountGT(Amount int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "amount", Operator: qb.Gt, Argument: Amount})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereAmountLE(Amount int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "amount", Operator: qb.Le, Argument: Amount})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereAmountLT(Amount int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "amount", Operator: qb.Lt, Argument: Amount})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_transfer_query_builder) WhereID(operator qb.Operator, ID int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereIDIs(ID int64) TransferQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_transfer_query_builder) WhereIDIn(IDs ...int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_transfer_query_builder) WhereIDNotIn(IDs ...int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereFromID compares from_id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_transfer_query_builder) WhereFromID(operator qb.Operator, FromID *int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "from_id", Operator: operator, Argument: FromID})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereFromIDIs(FromID *int64) TransferQueryBuilder {
+	if FromID == nil {
+		return q.WhereFromIDIsNull()
+	}
+
+	q.where = append(q.where, qb.Predicate{Column: "from_id", Operator: qb.Eq, Argument: FromID})
+	return q
+}
+
+// WhereFromIDIn matches rows whose from_id is one of FromIDs, an empty list matches nothing.
+func (q *_dont_use_transfer_query_builder) WhereFromIDIn(FromIDs ...*int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "from_id", Operator: qb.In, Arguments: qb.Args(FromIDs)})
+	return q
+}
+
+// WhereFromIDNotIn matches rows whose from_id is none of FromIDs, an empty list matches everything.
+func (q *_dont_use_transfer_query_builder) WhereFromIDNotIn(FromIDs ...*int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "from_id", Operator: qb.NotIn, Arguments: qb.Args(FromIDs)})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereFromIDIsNull() TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "from_id", Operator: qb.Is, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereFromIDIsNotNull() TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "from_id", Operator: qb.IsNot, Literal: "NULL"})
+	return q
+}
+
+// WhereToID compares to_id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_transfer_query_builder) WhereToID(operator qb.Operator, ToID sql.NullInt64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "to_id", Operator: operator, Argument: ToID})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereToIDIs(ToID sql.NullInt64) TransferQueryBuilder {
+	if !ToID.Valid {
+		return q.WhereToIDIsNull()
+	}
+
+	q.where = append(q.where, qb.Predicate{Column: "to_id", Operator: qb.Eq, Argument: ToID})
+	return q
+}
+
+// WhereToIDIn matches rows whose to_id is one of ToIDs, an empty list matches nothing.
+func (q *_dont_use_transfer_query_builder) WhereToIDIn(ToIDs ...sql.NullInt64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "to_id", Operator: qb.In, Arguments: qb.Args(ToIDs)})
+	return q
+}
+
+// WhereToIDNotIn matches rows whose to_id is none of ToIDs, an empty list matches everything.
+func (q *_dont_use_transfer_query_builder) WhereToIDNotIn(ToIDs ...sql.NullInt64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "to_id", Operator: qb.NotIn, Arguments: qb.Args(ToIDs)})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereToIDIsNull() TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "to_id", Operator: qb.Is, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereToIDIsNotNull() TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "to_id", Operator: qb.IsNot, Literal: "NULL"})
+	return q
+}
+
+// WhereAmount compares amount using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_transfer_query_builder) WhereAmount(operator qb.Operator, Amount int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "amount", Operator: operator, Argument: Amount})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) WhereAmountIs(Amount int64) TransferQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "amount", Operator: qb.Eq, Argument: Amount})
+	return q
+}
+
+// WhereAmountIn matches rows whose amount is one of Amounts, an empty list matches nothing.
+func (q *_dont_use_transfer_query_builder) WhereAmountIn(Amounts ...int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "amount", Operator: qb.In, Arguments: qb.Args(Amounts)})
+	return q
+}
+
+// WhereAmountNotIn matches rows whose amount is none of Amounts, an empty list matches everything.
+func (q *_dont_use_transfer_query_builder) WhereAmountNotIn(Amounts ...int64) TransferQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "amount", Operator: qb.NotIn, Arguments: qb.Args(Amounts)})
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) SetID(ID int64) TransferQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) SetFromID(FromID *int64) TransferQueryBuilder {
+	q.mode = "update"
+	q.set.FromID.argument = FromID
+	q.set.FromID.literal = ""
+	q.set.FromID.isSet = true
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) SetFromIDNull() TransferQueryBuilder {
+	q.mode = "update"
+	q.set.FromID.literal = "NULL"
+	q.set.FromID.isSet = true
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) SetToID(ToID sql.NullInt64) TransferQueryBuilder {
+	q.mode = "update"
+	q.set.ToID.argument = ToID
+	q.set.ToID.literal = ""
+	q.set.ToID.isSet = true
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) SetToIDNull() TransferQueryBuilder {
+	q.mode = "update"
+	q.set.ToID.literal = "NULL"
+	q.set.ToID.isSet = true
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) SetAmount(Amount int64) TransferQueryBuilder {
+	q.mode = "update"
+	q.set.Amount.argument = Amount
+	q.set.Amount.literal = ""
+	q.set.Amount.isSet = true
+	return q
+}
+
+func (q *_dont_use_transfer_query_builder) Add(ctx context.Context, db qb.Executor, record *Transfer) error {
+	query := "INSERT INTO transfers (from_id, to_id, amount) VALUES ($1, $2, $3) RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return db.QueryRowContext(ctx, query, record.FromID, record.ToID, record.Amount).Scan(&record.ID)
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from RETURNING assuming it yields the rows in the order
+// of VALUES, which postgres does in practice but doesn't document.
+func (q *_dont_use_transfer_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Transfer) error {
+	const chunkSize = 65535 / 3
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_transfer_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Transfer) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.FromID), q.bind(record.ToID), q.bind(record.Amount)}, ", ")+")")
+	}
+	query := "INSERT INTO transfers (from_id, to_id, amount) VALUES " + strings.Join(values, ", ") + " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	// assumes the ids come back in the order of the VALUES rows, see AddMany.
+	n := 0
+	for ; rows.Next(); n++ {
+		if n < len(records) {
+			if err := rows.Scan(&records[n].ID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if n != len(records) {
+		return fmt.Errorf("insert of %d Transfers returned %d ids", len(records), n)
+	}
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_transfer_query_builder) OnConflictUpdate(columns ...TransferColumn) TransferQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]TransferColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_transfer_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Transfer, conflictColumns ...TransferColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []TransferColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []TransferColumn{TransferColumns.FromID, TransferColumns.ToID, TransferColumns.Amount} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO transfers (from_id, to_id, amount) VALUES (" + strings.Join([]string{q.bind(record.FromID), q.bind(record.ToID), q.bind(record.Amount)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Transfer needs conflictColumns to update the conflicting row, the primary key of Transfer is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	query += " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.ID)
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+
+}
+
+	
\ No newline at end of file
@@ -0,0 +1,12 @@
+package postgres
+
+//go:generate go run ../../.. -dialect postgres -file $GOFILE
+
+// @querybuilder
+type Article struct {
+	ID    int64  `qb:"pk,autoincrement"`
+	Title string `db:"headline,omitempty"`
+	Body  string
+	Views int64 `qb:"readonly"`
+	Draft bool  `db:"-"`
+}
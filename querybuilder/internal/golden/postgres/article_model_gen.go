@@ -0,0 +1,1571 @@
+// Code generated by modelgen. DO NOT EDIT
+
+package postgres
+
+import (
+    "fmt"
+    "strings"
+    "database/sql"
+	"context"
+	"iter"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+
+
+type ArticleQueryBuilder interface {
+	WhereIDIs(int64) ArticleQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) ArticleQueryBuilder
+	WhereIDIn(...int64) ArticleQueryBuilder
+	WhereIDNotIn(...int64) ArticleQueryBuilder
+
+	WhereIDGT(int64) ArticleQueryBuilder
+	WhereIDGE(int64) ArticleQueryBuilder
+	WhereIDLT(int64) ArticleQueryBuilder
+	WhereIDLE(int64) ArticleQueryBuilder
+
+	WhereTitleIs(string) ArticleQueryBuilder
+	WhereTitle(operator qb.Operator, rhs string) ArticleQueryBuilder
+	WhereTitleIn(...string) ArticleQueryBuilder
+	WhereTitleNotIn(...string) ArticleQueryBuilder
+
+	WhereTitleLike(pattern string) ArticleQueryBuilder
+	WhereTitleILike(pattern string) ArticleQueryBuilder
+	WhereTitleStartsWith(prefix string) ArticleQueryBuilder
+	WhereTitleContains(substring string) ArticleQueryBuilder
+
+	WhereBodyIs(string) ArticleQueryBuilder
+	WhereBody(operator qb.Operator, rhs string) ArticleQueryBuilder
+	WhereBodyIn(...string) ArticleQueryBuilder
+	WhereBodyNotIn(...string) ArticleQueryBuilder
+
+	WhereBodyLike(pattern string) ArticleQueryBuilder
+	WhereBodyILike(pattern string) ArticleQueryBuilder
+	WhereBodyStartsWith(prefix string) ArticleQueryBuilder
+	WhereBodyContains(substring string) ArticleQueryBuilder
+
+	WhereViewsIs(int64) ArticleQueryBuilder
+	WhereViews(operator qb.Operator, rhs int64) ArticleQueryBuilder
+	WhereViewsIn(...int64) ArticleQueryBuilder
+	WhereViewsNotIn(...int64) ArticleQueryBuilder
+
+	WhereViewsGT(int64) ArticleQueryBuilder
+	WhereViewsGE(int64) ArticleQueryBuilder
+	WhereViewsLT(int64) ArticleQueryBuilder
+	WhereViewsLE(int64) ArticleQueryBuilder
+
+	WhereRaw(fragment string, args ...any) ArticleQueryBuilder
+
+	Or(func(b ArticleQueryBuilder)) ArticleQueryBuilder
+	And(func(b ArticleQueryBuilder)) ArticleQueryBuilder
+
+	OrderByAsc(column ArticleColumn) ArticleQueryBuilder
+	OrderByDesc(column ArticleColumn) ArticleQueryBuilder
+	OrderByRaw(fragment string, args ...any) ArticleQueryBuilder
+
+	Select(columns ...ArticleColumn) ArticleQueryBuilder
+	SelectRaw(fragment string, args ...any) ArticleQueryBuilder
+
+	GroupBy(columns ...ArticleColumn) ArticleQueryBuilder
+	Having(fragment string, args ...any) ArticleQueryBuilder
+	HavingCount(operator qb.Operator, count int64) ArticleQueryBuilder
+
+	After(cursor string) ArticleQueryBuilder
+	Before(cursor string) ArticleQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (ArticleCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (ArticlePage, error)
+
+	Limit(int) ArticleQueryBuilder
+	Offset(int) ArticleQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Article, error)
+	Last(ctx context.Context, db qb.Executor) (Article, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (Article, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+
+	SetID(int64) ArticleQueryBuilder
+
+	SetTitle(string) ArticleQueryBuilder
+
+	SetBody(string) ArticleQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Article) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Article) error
+
+	OnConflictUpdate(columns ...ArticleColumn) ArticleQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Article, conflictColumns ...ArticleColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Article, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Article, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Article, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Article) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]ArticleGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckTitle(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckBody(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckViews(ctx context.Context, db qb.Executor) ([]int64, error)
+
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SumViews(ctx context.Context, db qb.Executor) (int64, error)
+	MinViews(ctx context.Context, db qb.Executor) (int64, error)
+	MaxViews(ctx context.Context, db qb.Executor) (int64, error)
+	AvgViews(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() ArticleQueryBuilder
+}
+
+type _dont_use_article_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Title struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Body struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []ArticleColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []ArticleColumn
+
+	selected  []ArticleColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Article) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Articles() ArticleQueryBuilder {
+	return &_dont_use_article_query_builder{}
+}
+
+func (q *_dont_use_article_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type ArticleColumn string
+
+var ArticleColumns = struct {
+	ID    ArticleColumn
+	Title ArticleColumn
+	Body  ArticleColumn
+	Views ArticleColumn
+}{
+	ID:    ArticleColumn("id"),
+	Title: ArticleColumn("headline"),
+	Body:  ArticleColumn("body"),
+	Views: ArticleColumn("views"),
+}
+
+func (q *_dont_use_article_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_article_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_article_query_builder) Limit(l int) ArticleQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_article_query_builder) Offset(l int) ArticleQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Article) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.Title)
+	values = append(values, &q.Body)
+	values = append(values, &q.Views)
+
+	return values
+}
+
+func (q *_dont_use_article_query_builder) Debug() ArticleQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// ArticlesFromRows scans every column of Article from rows and closes them.
+func ArticlesFromRows(rows *sql.Rows) ([]Article, error) {
+	defer rows.Close()
+	var Articles []Article
+	for rows.Next() {
+		var m Article
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.Title,
+
+			&m.Body,
+
+			&m.Views,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Articles = append(Articles, m)
+	}
+	return Articles, rows.Err()
+}
+
+func ArticleFromRow(row *sql.Row) (Article, error) {
+	if row.Err() != nil {
+		return Article{}, row.Err()
+	}
+	var q Article
+	err := row.Scan(
+		&q.ID,
+		&q.Title,
+		&q.Body,
+		&q.Views,
+	)
+	if err != nil {
+		return Article{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_article_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_article_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_article_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Article, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_article_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Article, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_article_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Article) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_article_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Article, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Article{}, err
+	}
+	records := []Article{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Article{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_article_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Article, error] {
+	return func(yield func(Article, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Article{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Article
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Article{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Article{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_article_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Article) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(ArticleColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// ArticlePage is a page of Articles returned by Paginate, Page counts from 1.
+type ArticlePage struct {
+	Items    []Article
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_article_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_article_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (ArticlePage, error) {
+	if page < 1 || perPage < 1 {
+		return ArticlePage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return ArticlePage{}, err
+	}
+	result := ArticlePage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return ArticlePage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// ArticleCursorPage is a page of Articles returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type ArticleCursorPage struct {
+	Items []Article
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_article_query_builder) After(cursor string) ArticleQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_article_query_builder) Before(cursor string) ArticleQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_article_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []ArticleColumn{ArticleColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_article_query_builder) encodeCursor(record Article, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, ArticleColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_article_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Article
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, ArticleColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Article column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_article_query_builder) Page(ctx context.Context, db qb.Executor, size int) (ArticleCursorPage, error) {
+	if size <= 0 {
+		return ArticleCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return ArticleCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return ArticleCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]ArticleColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = ArticleColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return ArticleCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return ArticleCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return ArticleCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := ArticleCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return ArticleCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return ArticleCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_article_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Article, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_article_query_builder) GroupBy(columns ...ArticleColumn) ArticleQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_article_query_builder) Having(fragment string, args ...any) ArticleQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_article_query_builder) HavingCount(operator qb.Operator, count int64) ArticleQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// ArticleGroup is a group of Articles returned by FetchGroups, only the
+// GroupBy fields of the embedded Article are set.
+type ArticleGroup struct {
+	Article
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_article_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]ArticleGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []ArticleGroup
+	for rows.Next() {
+		var g ArticleGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Article), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_article_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_article_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []ArticleColumn{ArticleColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckTitle returns the headline of every matching row.
+func (q *_dont_use_article_query_builder) PluckTitle(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []ArticleColumn{ArticleColumns.Title}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckBody returns the body of every matching row.
+func (q *_dont_use_article_query_builder) PluckBody(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []ArticleColumn{ArticleColumns.Body}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckViews returns the views of every matching row.
+func (q *_dont_use_article_query_builder) PluckViews(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []ArticleColumn{ArticleColumns.Views}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_article_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM articles" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_article_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_article_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM articles" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_article_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
+}
+
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_article_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
+}
+
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_article_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
+}
+
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_article_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
+}
+
+// SumViews returns the sum of views over the matching rows, 0 if there are none.
+func (q *_dont_use_article_query_builder) SumViews(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(views)", &sum)
+	return sum.V, err
+}
+
+// MinViews returns the smallest views of the matching rows, 0 if there are none.
+func (q *_dont_use_article_query_builder) MinViews(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(views)", &min)
+	return min.V, err
+}
+
+// MaxViews returns the largest views of the matching rows, 0 if there are none.
+func (q *_dont_use_article_query_builder) MaxViews(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(views)", &max)
+	return max.V, err
+}
+
+// AvgViews returns the average of views over the matching rows, 0 if there are none.
+func (q *_dont_use_article_query_builder) AvgViews(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(views)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_article_query_builder) First(ctx context.Context, db qb.Executor) (Article, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Article{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Article{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_article_query_builder) Last(ctx context.Context, db qb.Executor) (Article, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Article{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Article{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_article_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (Article, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Article{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Article{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_article_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_article_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_article_query_builder) OrderByAsc(column ArticleColumn) ArticleQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) OrderByDesc(column ArticleColumn) ArticleQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_article_query_builder) OrderByRaw(fragment string, args ...any) ArticleQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Article in order, so they have to match them.
+func (q *_dont_use_article_query_builder) SelectRaw(fragment string, args ...any) ArticleQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Articles keep their zero value.
+func (q *_dont_use_article_query_builder) Select(columns ...ArticleColumn) ArticleQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_article_query_builder) selectedColumns() []ArticleColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []ArticleColumn{ArticleColumns.ID, ArticleColumns.Title, ArticleColumns.Body, ArticleColumns.Views}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_article_query_builder) requireSelected(columns ...ArticleColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_article_query_builder) target(m *Article, column ArticleColumn) interface{} {
+	switch column {
+	case ArticleColumns.ID:
+		return &m.ID
+	case ArticleColumns.Title:
+		return &m.Title
+	case ArticleColumns.Body:
+		return &m.Body
+	case ArticleColumns.Views:
+		return &m.Views
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_article_query_builder) scanTargets(m *Article) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_article_query_builder) scanRow(row *sql.Row) (Article, error) {
+	var m Article
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Article{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_article_query_builder) scanRows(rows *sql.Rows) ([]Article, error) {
+	var records []Article
+	for rows.Next() {
+		var m Article
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_article_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_article_query_builder) joinColumns(columns []ArticleColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Article{}, column) == nil {
+			return "", fmt.Errorf("unknown Article column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_article_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM articles", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_article_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE articles ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.Title.isSet {
+		rhs := q.set.Title.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Title.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "headline", rhs))
+	}
+
+	if q.set.Body.isSet {
+		rhs := q.set.Body.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Body.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "body", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_article_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM articles")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_article_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_article_query_builder) WhereRaw(fragment string, args ...any) ArticleQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from articles filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_article_query_builder) subquery(column ArticleColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM articles" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_article_query_builder) Or(fn func(b ArticleQueryBuilder)) ArticleQueryBuilder {
+	group := &_dont_use_article_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_article_query_builder) And(fn func(b ArticleQueryBuilder)) ArticleQueryBuilder {
+	group := &_dont_use_article_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereIDGE(ID int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereIDGT(ID int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereIDLE(ID int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereIDLT(ID int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereViewsGE(Views int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "views", Operator: qb.Ge, Argument: Views})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereViewsGT(Views int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "views", Operator: qb.Gt, Argument: Views})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereViewsLE(Views int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "views", Operator: qb.Le, Argument: Views})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereViewsLT(Views int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "views", Operator: qb.Lt, Argument: Views})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_article_query_builder) WhereID(operator qb.Operator, ID int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereIDIs(ID int64) ArticleQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_article_query_builder) WhereIDIn(IDs ...int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_article_query_builder) WhereIDNotIn(IDs ...int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereTitle compares headline using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_article_query_builder) WhereTitle(operator qb.Operator, Title string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "headline", Operator: operator, Argument: Title})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereTitleIs(Title string) ArticleQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "headline", Operator: qb.Eq, Argument: Title})
+	return q
+}
+
+// WhereTitleIn matches rows whose headline is one of Titles, an empty list matches nothing.
+func (q *_dont_use_article_query_builder) WhereTitleIn(Titles ...string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "headline", Operator: qb.In, Arguments: qb.Args(Titles)})
+	return q
+}
+
+// WhereTitleNotIn matches rows whose headline is none of Titles, an empty list matches everything.
+func (q *_dont_use_article_query_builder) WhereTitleNotIn(Titles ...string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "headline", Operator: qb.NotIn, Arguments: qb.Args(Titles)})
+	return q
+}
+
+// WhereTitleLike matches headline against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_article_query_builder) WhereTitleLike(pattern string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "headline", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereTitleILike matches headline against pattern ignoring case.
+func (q *_dont_use_article_query_builder) WhereTitleILike(pattern string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "headline", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereTitleStartsWith matches rows whose headline starts with prefix, prefix is matched literally.
+func (q *_dont_use_article_query_builder) WhereTitleStartsWith(prefix string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "headline LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereTitleContains matches rows whose headline contains substring, substring is matched literally.
+func (q *_dont_use_article_query_builder) WhereTitleContains(substring string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "headline LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereBody compares body using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_article_query_builder) WhereBody(operator qb.Operator, Body string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "body", Operator: operator, Argument: Body})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereBodyIs(Body string) ArticleQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "body", Operator: qb.Eq, Argument: Body})
+	return q
+}
+
+// WhereBodyIn matches rows whose body is one of Bodys, an empty list matches nothing.
+func (q *_dont_use_article_query_builder) WhereBodyIn(Bodys ...string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "body", Operator: qb.In, Arguments: qb.Args(Bodys)})
+	return q
+}
+
+// WhereBodyNotIn matches rows whose body is none of Bodys, an empty list matches everything.
+func (q *_dont_use_article_query_builder) WhereBodyNotIn(Bodys ...string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "body", Operator: qb.NotIn, Arguments: qb.Args(Bodys)})
+	return q
+}
+
+// WhereBodyLike matches body against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_article_query_builder) WhereBodyLike(pattern string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "body", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereBodyILike matches body against pattern ignoring case.
+func (q *_dont_use_article_query_builder) WhereBodyILike(pattern string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "body", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereBodyStartsWith matches rows whose body starts with prefix, prefix is matched literally.
+func (q *_dont_use_article_query_builder) WhereBodyStartsWith(prefix string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "body LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereBodyContains matches rows whose body contains substring, substring is matched literally.
+func (q *_dont_use_article_query_builder) WhereBodyContains(substring string) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "body LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereViews compares views using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_article_query_builder) WhereViews(operator qb.Operator, Views int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "views", Operator: operator, Argument: Views})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) WhereViewsIs(Views int64) ArticleQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "views", Operator: qb.Eq, Argument: Views})
+	return q
+}
+
+// WhereViewsIn matches rows whose views is one of Viewss, an empty list matches nothing.
+func (q *_dont_use_article_query_builder) WhereViewsIn(Viewss ...int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "views", Operator: qb.In, Arguments: qb.Args(Viewss)})
+	return q
+}
+
+// WhereViewsNotIn matches rows whose views is none of Viewss, an empty list matches everything.
+func (q *_dont_use_article_query_builder) WhereViewsNotIn(Viewss ...int64) ArticleQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "views", Operator: qb.NotIn, Arguments: qb.Args(Viewss)})
+	return q
+}
+
+func (q *_dont_use_article_query_builder) SetID(ID int64) ArticleQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_article_query_builder) SetTitle(Title string) ArticleQueryBuilder {
+	q.mode = "update"
+	q.set.Title.argument = Title
+	q.set.Title.literal = ""
+	q.set.Title.isSet = true
+	return q
+}
+
+func (q *_dont_use_article_query_builder) SetBody(Body string) ArticleQueryBuilder {
+	q.mode = "update"
+	q.set.Body.argument = Body
+	q.set.Body.literal = ""
+	q.set.Body.isSet = true
+	return q
+}
+
+func (q *_dont_use_article_query_builder) Add(ctx context.Context, db qb.Executor, record *Article) error {
+	query := "INSERT INTO articles (headline, body) VALUES ($1, $2) RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return db.QueryRowContext(ctx, query, record.Title, record.Body).Scan(&record.ID)
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from RETURNING assuming it yields the rows in the order
+// of VALUES, which postgres does in practice but doesn't document.
+func (q *_dont_use_article_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Article) error {
+	const chunkSize = 65535 / 2
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_article_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Article) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Title), q.bind(record.Body)}, ", ")+")")
+	}
+	query := "INSERT INTO articles (headline, body) VALUES " + strings.Join(values, ", ") + " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	// assumes the ids come back in the order of the VALUES rows, see AddMany.
+	n := 0
+	for ; rows.Next(); n++ {
+		if n < len(records) {
+			if err := rows.Scan(&records[n].ID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if n != len(records) {
+		return fmt.Errorf("insert of %d Articles returned %d ids", len(records), n)
+	}
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_article_query_builder) OnConflictUpdate(columns ...ArticleColumn) ArticleQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]ArticleColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_article_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Article, conflictColumns ...ArticleColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []ArticleColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []ArticleColumn{ArticleColumns.Title, ArticleColumns.Body} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO articles (headline, body) VALUES (" + strings.Join([]string{q.bind(record.Title), q.bind(record.Body)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Article needs conflictColumns to update the conflicting row, the primary key of Article is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	query += " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.ID)
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+
+}
+
+	
\ No newline at end of file
@@ -0,0 +1,24 @@
+package postgres
+
+//go:generate go run ../../.. -dialect postgres -file $GOFILE
+
+// @querybuilder
+//
+// Tag and Token don't tag a primary key so ID is theirs, only the integer ID of
+// Tag is filled in by the database.
+type Tag struct {
+	ID   int64
+	Name string
+}
+
+// @querybuilder
+type Token struct {
+	ID     string
+	UserID int64
+}
+
+// @querybuilder pk=Code
+type Country struct {
+	Code string
+	Name string
+}
@@ -0,0 +1,3965 @@
+// Code generated by modelgen. DO NOT EDIT
+
+package postgres
+
+import (
+    "fmt"
+    "strings"
+    "database/sql"
+	"context"
+	"iter"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+
+
+type TagQueryBuilder interface {
+	WhereIDIs(int64) TagQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) TagQueryBuilder
+	WhereIDIn(...int64) TagQueryBuilder
+	WhereIDNotIn(...int64) TagQueryBuilder
+
+	WhereIDGT(int64) TagQueryBuilder
+	WhereIDGE(int64) TagQueryBuilder
+	WhereIDLT(int64) TagQueryBuilder
+	WhereIDLE(int64) TagQueryBuilder
+
+	WhereNameIs(string) TagQueryBuilder
+	WhereName(operator qb.Operator, rhs string) TagQueryBuilder
+	WhereNameIn(...string) TagQueryBuilder
+	WhereNameNotIn(...string) TagQueryBuilder
+
+	WhereNameLike(pattern string) TagQueryBuilder
+	WhereNameILike(pattern string) TagQueryBuilder
+	WhereNameStartsWith(prefix string) TagQueryBuilder
+	WhereNameContains(substring string) TagQueryBuilder
+
+	WhereRaw(fragment string, args ...any) TagQueryBuilder
+
+	Or(func(b TagQueryBuilder)) TagQueryBuilder
+	And(func(b TagQueryBuilder)) TagQueryBuilder
+
+	OrderByAsc(column TagColumn) TagQueryBuilder
+	OrderByDesc(column TagColumn) TagQueryBuilder
+	OrderByRaw(fragment string, args ...any) TagQueryBuilder
+
+	Select(columns ...TagColumn) TagQueryBuilder
+	SelectRaw(fragment string, args ...any) TagQueryBuilder
+
+	GroupBy(columns ...TagColumn) TagQueryBuilder
+	Having(fragment string, args ...any) TagQueryBuilder
+	HavingCount(operator qb.Operator, count int64) TagQueryBuilder
+
+	After(cursor string) TagQueryBuilder
+	Before(cursor string) TagQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (TagCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (TagPage, error)
+
+	Limit(int) TagQueryBuilder
+	Offset(int) TagQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Tag, error)
+	Last(ctx context.Context, db qb.Executor) (Tag, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (Tag, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+
+	SetID(int64) TagQueryBuilder
+
+	SetName(string) TagQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Tag) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Tag) error
+
+	OnConflictUpdate(columns ...TagColumn) TagQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Tag, conflictColumns ...TagColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Tag, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Tag, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Tag, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Tag) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]TagGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckName(ctx context.Context, db qb.Executor) ([]string, error)
+
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() TagQueryBuilder
+}
+
+type _dont_use_tag_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Name struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []TagColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []TagColumn
+
+	selected  []TagColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Tag) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Tags() TagQueryBuilder {
+	return &_dont_use_tag_query_builder{}
+}
+
+func (q *_dont_use_tag_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type TagColumn string
+
+var TagColumns = struct {
+	ID   TagColumn
+	Name TagColumn
+}{
+	ID:   TagColumn("id"),
+	Name: TagColumn("name"),
+}
+
+func (q *_dont_use_tag_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_tag_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_tag_query_builder) Limit(l int) TagQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) Offset(l int) TagQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Tag) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.Name)
+
+	return values
+}
+
+func (q *_dont_use_tag_query_builder) Debug() TagQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// TagsFromRows scans every column of Tag from rows and closes them.
+func TagsFromRows(rows *sql.Rows) ([]Tag, error) {
+	defer rows.Close()
+	var Tags []Tag
+	for rows.Next() {
+		var m Tag
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.Name,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Tags = append(Tags, m)
+	}
+	return Tags, rows.Err()
+}
+
+func TagFromRow(row *sql.Row) (Tag, error) {
+	if row.Err() != nil {
+		return Tag{}, row.Err()
+	}
+	var q Tag
+	err := row.Scan(
+		&q.ID,
+		&q.Name,
+	)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_tag_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_tag_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_tag_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Tag, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_tag_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Tag, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_tag_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Tag) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_tag_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Tag, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Tag{}, err
+	}
+	records := []Tag{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Tag{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_tag_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Tag, error] {
+	return func(yield func(Tag, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Tag{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Tag
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Tag{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Tag{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_tag_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Tag) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(TagColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// TagPage is a page of Tags returned by Paginate, Page counts from 1.
+type TagPage struct {
+	Items    []Tag
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_tag_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_tag_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (TagPage, error) {
+	if page < 1 || perPage < 1 {
+		return TagPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return TagPage{}, err
+	}
+	result := TagPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return TagPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// TagCursorPage is a page of Tags returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type TagCursorPage struct {
+	Items []Tag
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_tag_query_builder) After(cursor string) TagQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_tag_query_builder) Before(cursor string) TagQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_tag_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []TagColumn{TagColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_tag_query_builder) encodeCursor(record Tag, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, TagColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_tag_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Tag
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, TagColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Tag column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_tag_query_builder) Page(ctx context.Context, db qb.Executor, size int) (TagCursorPage, error) {
+	if size <= 0 {
+		return TagCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return TagCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return TagCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]TagColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = TagColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return TagCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return TagCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return TagCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := TagCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return TagCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return TagCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_tag_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Tag, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_tag_query_builder) GroupBy(columns ...TagColumn) TagQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_tag_query_builder) Having(fragment string, args ...any) TagQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_tag_query_builder) HavingCount(operator qb.Operator, count int64) TagQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// TagGroup is a group of Tags returned by FetchGroups, only the
+// GroupBy fields of the embedded Tag are set.
+type TagGroup struct {
+	Tag
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_tag_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]TagGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []TagGroup
+	for rows.Next() {
+		var g TagGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Tag), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_tag_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_tag_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []TagColumn{TagColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckName returns the name of every matching row.
+func (q *_dont_use_tag_query_builder) PluckName(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []TagColumn{TagColumns.Name}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_tag_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM tags" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_tag_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_tag_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM tags" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_tag_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
+}
+
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_tag_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
+}
+
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_tag_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
+}
+
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_tag_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_tag_query_builder) First(ctx context.Context, db qb.Executor) (Tag, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Tag{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Tag{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_tag_query_builder) Last(ctx context.Context, db qb.Executor) (Tag, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Tag{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Tag{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_tag_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (Tag, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Tag{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Tag{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_tag_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_tag_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_tag_query_builder) OrderByAsc(column TagColumn) TagQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) OrderByDesc(column TagColumn) TagQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_tag_query_builder) OrderByRaw(fragment string, args ...any) TagQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Tag in order, so they have to match them.
+func (q *_dont_use_tag_query_builder) SelectRaw(fragment string, args ...any) TagQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Tags keep their zero value.
+func (q *_dont_use_tag_query_builder) Select(columns ...TagColumn) TagQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) selectedColumns() []TagColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []TagColumn{TagColumns.ID, TagColumns.Name}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_tag_query_builder) requireSelected(columns ...TagColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_tag_query_builder) target(m *Tag, column TagColumn) interface{} {
+	switch column {
+	case TagColumns.ID:
+		return &m.ID
+	case TagColumns.Name:
+		return &m.Name
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_tag_query_builder) scanTargets(m *Tag) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_tag_query_builder) scanRow(row *sql.Row) (Tag, error) {
+	var m Tag
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Tag{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_tag_query_builder) scanRows(rows *sql.Rows) ([]Tag, error) {
+	var records []Tag
+	for rows.Next() {
+		var m Tag
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_tag_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_tag_query_builder) joinColumns(columns []TagColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Tag{}, column) == nil {
+			return "", fmt.Errorf("unknown Tag column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_tag_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM tags", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_tag_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE tags ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.Name.isSet {
+		rhs := q.set.Name.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Name.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "name", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_tag_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM tags")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_tag_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_tag_query_builder) WhereRaw(fragment string, args ...any) TagQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from tags filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_tag_query_builder) subquery(column TagColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM tags" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_tag_query_builder) Or(fn func(b TagQueryBuilder)) TagQueryBuilder {
+	group := &_dont_use_tag_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_tag_query_builder) And(fn func(b TagQueryBuilder)) TagQueryBuilder {
+	group := &_dont_use_tag_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) WhereIDGE(ID int64) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) WhereIDGT(ID int64) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) WhereIDLE(ID int64) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) WhereIDLT(ID int64) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_tag_query_builder) WhereID(operator qb.Operator, ID int64) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) WhereIDIs(ID int64) TagQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_tag_query_builder) WhereIDIn(IDs ...int64) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_tag_query_builder) WhereIDNotIn(IDs ...int64) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereName compares name using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_tag_query_builder) WhereName(operator qb.Operator, Name string) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: operator, Argument: Name})
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) WhereNameIs(Name string) TagQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Eq, Argument: Name})
+	return q
+}
+
+// WhereNameIn matches rows whose name is one of Names, an empty list matches nothing.
+func (q *_dont_use_tag_query_builder) WhereNameIn(Names ...string) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.In, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameNotIn matches rows whose name is none of Names, an empty list matches everything.
+func (q *_dont_use_tag_query_builder) WhereNameNotIn(Names ...string) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.NotIn, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameLike matches name against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_tag_query_builder) WhereNameLike(pattern string) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNameILike matches name against pattern ignoring case.
+func (q *_dont_use_tag_query_builder) WhereNameILike(pattern string) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereNameStartsWith matches rows whose name starts with prefix, prefix is matched literally.
+func (q *_dont_use_tag_query_builder) WhereNameStartsWith(prefix string) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNameContains matches rows whose name contains substring, substring is matched literally.
+func (q *_dont_use_tag_query_builder) WhereNameContains(substring string) TagQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) SetID(ID int64) TagQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) SetName(Name string) TagQueryBuilder {
+	q.mode = "update"
+	q.set.Name.argument = Name
+	q.set.Name.literal = ""
+	q.set.Name.isSet = true
+	return q
+}
+
+func (q *_dont_use_tag_query_builder) Add(ctx context.Context, db qb.Executor, record *Tag) error {
+	query := "INSERT INTO tags (name) VALUES ($1) RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return db.QueryRowContext(ctx, query, record.Name).Scan(&record.ID)
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from RETURNING assuming it yields the rows in the order
+// of VALUES, which postgres does in practice but doesn't document.
+func (q *_dont_use_tag_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Tag) error {
+	const chunkSize = 65535 / 1
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_tag_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Tag) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Name)}, ", ")+")")
+	}
+	query := "INSERT INTO tags (name) VALUES " + strings.Join(values, ", ") + " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	// assumes the ids come back in the order of the VALUES rows, see AddMany.
+	n := 0
+	for ; rows.Next(); n++ {
+		if n < len(records) {
+			if err := rows.Scan(&records[n].ID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if n != len(records) {
+		return fmt.Errorf("insert of %d Tags returned %d ids", len(records), n)
+	}
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_tag_query_builder) OnConflictUpdate(columns ...TagColumn) TagQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]TagColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_tag_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Tag, conflictColumns ...TagColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []TagColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []TagColumn{TagColumns.Name} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO tags (name) VALUES (" + strings.Join([]string{q.bind(record.Name)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Tag needs conflictColumns to update the conflicting row, the primary key of Tag is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	query += " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.ID)
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+
+}
+
+
+
+
+type TokenQueryBuilder interface {
+	WhereIDIs(string) TokenQueryBuilder
+	WhereID(operator qb.Operator, rhs string) TokenQueryBuilder
+	WhereIDIn(...string) TokenQueryBuilder
+	WhereIDNotIn(...string) TokenQueryBuilder
+
+	WhereIDLike(pattern string) TokenQueryBuilder
+	WhereIDILike(pattern string) TokenQueryBuilder
+	WhereIDStartsWith(prefix string) TokenQueryBuilder
+	WhereIDContains(substring string) TokenQueryBuilder
+
+	WhereUserIDIs(int64) TokenQueryBuilder
+	WhereUserID(operator qb.Operator, rhs int64) TokenQueryBuilder
+	WhereUserIDIn(...int64) TokenQueryBuilder
+	WhereUserIDNotIn(...int64) TokenQueryBuilder
+
+	WhereUserIDGT(int64) TokenQueryBuilder
+	WhereUserIDGE(int64) TokenQueryBuilder
+	WhereUserIDLT(int64) TokenQueryBuilder
+	WhereUserIDLE(int64) TokenQueryBuilder
+
+	WhereRaw(fragment string, args ...any) TokenQueryBuilder
+
+	Or(func(b TokenQueryBuilder)) TokenQueryBuilder
+	And(func(b TokenQueryBuilder)) TokenQueryBuilder
+
+	OrderByAsc(column TokenColumn) TokenQueryBuilder
+	OrderByDesc(column TokenColumn) TokenQueryBuilder
+	OrderByRaw(fragment string, args ...any) TokenQueryBuilder
+
+	Select(columns ...TokenColumn) TokenQueryBuilder
+	SelectRaw(fragment string, args ...any) TokenQueryBuilder
+
+	GroupBy(columns ...TokenColumn) TokenQueryBuilder
+	Having(fragment string, args ...any) TokenQueryBuilder
+	HavingCount(operator qb.Operator, count int64) TokenQueryBuilder
+
+	After(cursor string) TokenQueryBuilder
+	Before(cursor string) TokenQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (TokenCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (TokenPage, error)
+
+	Limit(int) TokenQueryBuilder
+	Offset(int) TokenQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Token, error)
+	Last(ctx context.Context, db qb.Executor) (Token, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID string) (Token, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID string) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID string) (sql.Result, error)
+
+	SetID(string) TokenQueryBuilder
+
+	SetUserID(int64) TokenQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Token) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Token) error
+
+	OnConflictUpdate(columns ...TokenColumn) TokenQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Token, conflictColumns ...TokenColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Token, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Token, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Token, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Token) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]TokenGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckUserID(ctx context.Context, db qb.Executor) ([]int64, error)
+
+	SumUserID(ctx context.Context, db qb.Executor) (int64, error)
+	MinUserID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxUserID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgUserID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() TokenQueryBuilder
+}
+
+type _dont_use_token_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		UserID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []TokenColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []TokenColumn
+
+	selected  []TokenColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Token) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Tokens() TokenQueryBuilder {
+	return &_dont_use_token_query_builder{}
+}
+
+func (q *_dont_use_token_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type TokenColumn string
+
+var TokenColumns = struct {
+	ID     TokenColumn
+	UserID TokenColumn
+}{
+	ID:     TokenColumn("id"),
+	UserID: TokenColumn("user_id"),
+}
+
+func (q *_dont_use_token_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_token_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_token_query_builder) Limit(l int) TokenQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_token_query_builder) Offset(l int) TokenQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Token) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.UserID)
+
+	return values
+}
+
+func (q *_dont_use_token_query_builder) Debug() TokenQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// TokensFromRows scans every column of Token from rows and closes them.
+func TokensFromRows(rows *sql.Rows) ([]Token, error) {
+	defer rows.Close()
+	var Tokens []Token
+	for rows.Next() {
+		var m Token
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.UserID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Tokens = append(Tokens, m)
+	}
+	return Tokens, rows.Err()
+}
+
+func TokenFromRow(row *sql.Row) (Token, error) {
+	if row.Err() != nil {
+		return Token{}, row.Err()
+	}
+	var q Token
+	err := row.Scan(
+		&q.ID,
+		&q.UserID,
+	)
+	if err != nil {
+		return Token{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_token_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_token_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_token_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Token, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_token_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Token, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_token_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Token) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_token_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Token, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Token{}, err
+	}
+	records := []Token{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Token{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_token_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Token, error] {
+	return func(yield func(Token, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Token{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Token
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Token{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Token{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_token_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Token) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(TokenColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// TokenPage is a page of Tokens returned by Paginate, Page counts from 1.
+type TokenPage struct {
+	Items    []Token
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_token_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_token_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (TokenPage, error) {
+	if page < 1 || perPage < 1 {
+		return TokenPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return TokenPage{}, err
+	}
+	result := TokenPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return TokenPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// TokenCursorPage is a page of Tokens returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type TokenCursorPage struct {
+	Items []Token
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_token_query_builder) After(cursor string) TokenQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_token_query_builder) Before(cursor string) TokenQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_token_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []TokenColumn{TokenColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_token_query_builder) encodeCursor(record Token, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, TokenColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_token_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Token
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, TokenColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Token column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_token_query_builder) Page(ctx context.Context, db qb.Executor, size int) (TokenCursorPage, error) {
+	if size <= 0 {
+		return TokenCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return TokenCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return TokenCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]TokenColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = TokenColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return TokenCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return TokenCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return TokenCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := TokenCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return TokenCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return TokenCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_token_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Token, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_token_query_builder) GroupBy(columns ...TokenColumn) TokenQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_token_query_builder) Having(fragment string, args ...any) TokenQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_token_query_builder) HavingCount(operator qb.Operator, count int64) TokenQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// TokenGroup is a group of Tokens returned by FetchGroups, only the
+// GroupBy fields of the embedded Token are set.
+type TokenGroup struct {
+	Token
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_token_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]TokenGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []TokenGroup
+	for rows.Next() {
+		var g TokenGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Token), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_token_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_token_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []TokenColumn{TokenColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckUserID returns the user_id of every matching row.
+func (q *_dont_use_token_query_builder) PluckUserID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []TokenColumn{TokenColumns.UserID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_token_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM tokens" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_token_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_token_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM tokens" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumUserID returns the sum of user_id over the matching rows, 0 if there are none.
+func (q *_dont_use_token_query_builder) SumUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(user_id)", &sum)
+	return sum.V, err
+}
+
+// MinUserID returns the smallest user_id of the matching rows, 0 if there are none.
+func (q *_dont_use_token_query_builder) MinUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(user_id)", &min)
+	return min.V, err
+}
+
+// MaxUserID returns the largest user_id of the matching rows, 0 if there are none.
+func (q *_dont_use_token_query_builder) MaxUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(user_id)", &max)
+	return max.V, err
+}
+
+// AvgUserID returns the average of user_id over the matching rows, 0 if there are none.
+func (q *_dont_use_token_query_builder) AvgUserID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(user_id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_token_query_builder) First(ctx context.Context, db qb.Executor) (Token, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Token{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Token{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_token_query_builder) Last(ctx context.Context, db qb.Executor) (Token, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Token{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Token{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_token_query_builder) FindByID(ctx context.Context, db qb.Executor, ID string) (Token, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Token{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Token{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_token_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID string) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_token_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID string) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_token_query_builder) OrderByAsc(column TokenColumn) TokenQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_token_query_builder) OrderByDesc(column TokenColumn) TokenQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_token_query_builder) OrderByRaw(fragment string, args ...any) TokenQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Token in order, so they have to match them.
+func (q *_dont_use_token_query_builder) SelectRaw(fragment string, args ...any) TokenQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Tokens keep their zero value.
+func (q *_dont_use_token_query_builder) Select(columns ...TokenColumn) TokenQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_token_query_builder) selectedColumns() []TokenColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []TokenColumn{TokenColumns.ID, TokenColumns.UserID}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_token_query_builder) requireSelected(columns ...TokenColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_token_query_builder) target(m *Token, column TokenColumn) interface{} {
+	switch column {
+	case TokenColumns.ID:
+		return &m.ID
+	case TokenColumns.UserID:
+		return &m.UserID
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_token_query_builder) scanTargets(m *Token) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_token_query_builder) scanRow(row *sql.Row) (Token, error) {
+	var m Token
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Token{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_token_query_builder) scanRows(rows *sql.Rows) ([]Token, error) {
+	var records []Token
+	for rows.Next() {
+		var m Token
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_token_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_token_query_builder) joinColumns(columns []TokenColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Token{}, column) == nil {
+			return "", fmt.Errorf("unknown Token column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_token_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM tokens", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_token_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE tokens ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.UserID.isSet {
+		rhs := q.set.UserID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.UserID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "user_id", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_token_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM tokens")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_token_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_token_query_builder) WhereRaw(fragment string, args ...any) TokenQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from tokens filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_token_query_builder) subquery(column TokenColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM tokens" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_token_query_builder) Or(fn func(b TokenQueryBuilder)) TokenQueryBuilder {
+	group := &_dont_use_token_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_token_query_builder) And(fn func(b TokenQueryBuilder)) TokenQueryBuilder {
+	group := &_dont_use_token_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_token_query_builder) WhereUserIDGE(UserID int64) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Ge, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_token_query_builder) WhereUserIDGT(UserID int64) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Gt, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_token_query_builder) WhereUserIDLE(UserID int64) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Le, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_token_query_builder) WhereUserIDLT(UserID int64) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Lt, Argument: UserID})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_token_query_builder) WhereID(operator qb.Operator, ID string) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_token_query_builder) WhereIDIs(ID string) TokenQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_token_query_builder) WhereIDIn(IDs ...string) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_token_query_builder) WhereIDNotIn(IDs ...string) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDLike matches id against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_token_query_builder) WhereIDLike(pattern string) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereIDILike matches id against pattern ignoring case.
+func (q *_dont_use_token_query_builder) WhereIDILike(pattern string) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereIDStartsWith matches rows whose id starts with prefix, prefix is matched literally.
+func (q *_dont_use_token_query_builder) WhereIDStartsWith(prefix string) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "id LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereIDContains matches rows whose id contains substring, substring is matched literally.
+func (q *_dont_use_token_query_builder) WhereIDContains(substring string) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "id LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereUserID compares user_id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_token_query_builder) WhereUserID(operator qb.Operator, UserID int64) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: operator, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_token_query_builder) WhereUserIDIs(UserID int64) TokenQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Eq, Argument: UserID})
+	return q
+}
+
+// WhereUserIDIn matches rows whose user_id is one of UserIDs, an empty list matches nothing.
+func (q *_dont_use_token_query_builder) WhereUserIDIn(UserIDs ...int64) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.In, Arguments: qb.Args(UserIDs)})
+	return q
+}
+
+// WhereUserIDNotIn matches rows whose user_id is none of UserIDs, an empty list matches everything.
+func (q *_dont_use_token_query_builder) WhereUserIDNotIn(UserIDs ...int64) TokenQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.NotIn, Arguments: qb.Args(UserIDs)})
+	return q
+}
+
+func (q *_dont_use_token_query_builder) SetID(ID string) TokenQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_token_query_builder) SetUserID(UserID int64) TokenQueryBuilder {
+	q.mode = "update"
+	q.set.UserID.argument = UserID
+	q.set.UserID.literal = ""
+	q.set.UserID.isSet = true
+	return q
+}
+
+func (q *_dont_use_token_query_builder) Add(ctx context.Context, db qb.Executor, record *Token) error {
+	query := "INSERT INTO tokens (id, user_id) VALUES ($1, $2)"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, record.ID, record.UserID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+func (q *_dont_use_token_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Token) error {
+	const chunkSize = 65535 / 2
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_token_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Token) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.ID), q.bind(record.UserID)}, ", ")+")")
+	}
+	query := "INSERT INTO tokens (id, user_id) VALUES " + strings.Join(values, ", ")
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_token_query_builder) OnConflictUpdate(columns ...TokenColumn) TokenQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]TokenColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_token_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Token, conflictColumns ...TokenColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []TokenColumn{TokenColumns.ID}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []TokenColumn{TokenColumns.ID, TokenColumns.UserID} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO tokens (id, user_id) VALUES (" + strings.Join([]string{q.bind(record.ID), q.bind(record.UserID)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Token needs conflictColumns to update the conflicting row, the primary key of Token is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	return err
+
+}
+
+
+
+
+type CountryQueryBuilder interface {
+	WhereCodeIs(string) CountryQueryBuilder
+	WhereCode(operator qb.Operator, rhs string) CountryQueryBuilder
+	WhereCodeIn(...string) CountryQueryBuilder
+	WhereCodeNotIn(...string) CountryQueryBuilder
+
+	WhereCodeLike(pattern string) CountryQueryBuilder
+	WhereCodeILike(pattern string) CountryQueryBuilder
+	WhereCodeStartsWith(prefix string) CountryQueryBuilder
+	WhereCodeContains(substring string) CountryQueryBuilder
+
+	WhereNameIs(string) CountryQueryBuilder
+	WhereName(operator qb.Operator, rhs string) CountryQueryBuilder
+	WhereNameIn(...string) CountryQueryBuilder
+	WhereNameNotIn(...string) CountryQueryBuilder
+
+	WhereNameLike(pattern string) CountryQueryBuilder
+	WhereNameILike(pattern string) CountryQueryBuilder
+	WhereNameStartsWith(prefix string) CountryQueryBuilder
+	WhereNameContains(substring string) CountryQueryBuilder
+
+	WhereRaw(fragment string, args ...any) CountryQueryBuilder
+
+	Or(func(b CountryQueryBuilder)) CountryQueryBuilder
+	And(func(b CountryQueryBuilder)) CountryQueryBuilder
+
+	OrderByAsc(column CountryColumn) CountryQueryBuilder
+	OrderByDesc(column CountryColumn) CountryQueryBuilder
+	OrderByRaw(fragment string, args ...any) CountryQueryBuilder
+
+	Select(columns ...CountryColumn) CountryQueryBuilder
+	SelectRaw(fragment string, args ...any) CountryQueryBuilder
+
+	GroupBy(columns ...CountryColumn) CountryQueryBuilder
+	Having(fragment string, args ...any) CountryQueryBuilder
+	HavingCount(operator qb.Operator, count int64) CountryQueryBuilder
+
+	After(cursor string) CountryQueryBuilder
+	Before(cursor string) CountryQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (CountryCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (CountryPage, error)
+
+	Limit(int) CountryQueryBuilder
+	Offset(int) CountryQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Country, error)
+	Last(ctx context.Context, db qb.Executor) (Country, error)
+
+	FindByCode(ctx context.Context, db qb.Executor, Code string) (Country, error)
+	DeleteByCode(ctx context.Context, db qb.Executor, Code string) (sql.Result, error)
+	UpdateByCode(ctx context.Context, db qb.Executor, Code string) (sql.Result, error)
+
+	SetCode(string) CountryQueryBuilder
+
+	SetName(string) CountryQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Country) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Country) error
+
+	OnConflictUpdate(columns ...CountryColumn) CountryQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Country, conflictColumns ...CountryColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Country, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Country, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Country, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Country) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]CountryGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckCode(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckName(ctx context.Context, db qb.Executor) ([]string, error)
+
+	SQL() (string, error)
+
+	Debug() CountryQueryBuilder
+}
+
+type _dont_use_country_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		Code struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Name struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []CountryColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []CountryColumn
+
+	selected  []CountryColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Country) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Countrys() CountryQueryBuilder {
+	return &_dont_use_country_query_builder{}
+}
+
+func (q *_dont_use_country_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type CountryColumn string
+
+var CountryColumns = struct {
+	Code CountryColumn
+	Name CountryColumn
+}{
+	Code: CountryColumn("code"),
+	Name: CountryColumn("name"),
+}
+
+func (q *_dont_use_country_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_country_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_country_query_builder) Limit(l int) CountryQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_country_query_builder) Offset(l int) CountryQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Country) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.Code)
+	values = append(values, &q.Name)
+
+	return values
+}
+
+func (q *_dont_use_country_query_builder) Debug() CountryQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// CountrysFromRows scans every column of Country from rows and closes them.
+func CountrysFromRows(rows *sql.Rows) ([]Country, error) {
+	defer rows.Close()
+	var Countrys []Country
+	for rows.Next() {
+		var m Country
+		err := rows.Scan(
+
+			&m.Code,
+
+			&m.Name,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Countrys = append(Countrys, m)
+	}
+	return Countrys, rows.Err()
+}
+
+func CountryFromRow(row *sql.Row) (Country, error) {
+	if row.Err() != nil {
+		return Country{}, row.Err()
+	}
+	var q Country
+	err := row.Scan(
+		&q.Code,
+		&q.Name,
+	)
+	if err != nil {
+		return Country{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_country_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_country_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_country_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Country, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_country_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Country, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_country_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Country) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_country_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Country, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Country{}, err
+	}
+	records := []Country{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Country{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_country_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Country, error] {
+	return func(yield func(Country, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Country{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Country
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Country{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Country{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_country_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Country) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(CountryColumns.Code); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "code ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "code", Operator: qb.Gt, Argument: last.Code})
+	}
+}
+
+// CountryPage is a page of Countrys returned by Paginate, Page counts from 1.
+type CountryPage struct {
+	Items    []Country
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_country_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_country_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (CountryPage, error) {
+	if page < 1 || perPage < 1 {
+		return CountryPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return CountryPage{}, err
+	}
+	result := CountryPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return CountryPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// CountryCursorPage is a page of Countrys returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type CountryCursorPage struct {
+	Items []Country
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_country_query_builder) After(cursor string) CountryQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_country_query_builder) Before(cursor string) CountryQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_country_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []CountryColumn{CountryColumns.Code} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_country_query_builder) encodeCursor(record Country, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, CountryColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_country_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Country
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, CountryColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Country column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_country_query_builder) Page(ctx context.Context, db qb.Executor, size int) (CountryCursorPage, error) {
+	if size <= 0 {
+		return CountryCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return CountryCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return CountryCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]CountryColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = CountryColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return CountryCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return CountryCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return CountryCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := CountryCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return CountryCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return CountryCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_country_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Country, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_country_query_builder) GroupBy(columns ...CountryColumn) CountryQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_country_query_builder) Having(fragment string, args ...any) CountryQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_country_query_builder) HavingCount(operator qb.Operator, count int64) CountryQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// CountryGroup is a group of Countrys returned by FetchGroups, only the
+// GroupBy fields of the embedded Country are set.
+type CountryGroup struct {
+	Country
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_country_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]CountryGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []CountryGroup
+	for rows.Next() {
+		var g CountryGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Country), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_country_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckCode returns the code of every matching row.
+func (q *_dont_use_country_query_builder) PluckCode(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []CountryColumn{CountryColumns.Code}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckName returns the name of every matching row.
+func (q *_dont_use_country_query_builder) PluckName(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []CountryColumn{CountryColumns.Name}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_country_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM countries" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_country_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_country_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM countries" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+func (q *_dont_use_country_query_builder) First(ctx context.Context, db qb.Executor) (Country, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "code ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Country{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Country{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_country_query_builder) Last(ctx context.Context, db qb.Executor) (Country, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "code DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Country{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Country{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_country_query_builder) FindByCode(ctx context.Context, db qb.Executor, Code string) (Country, error) {
+	q.WhereCodeIs(Code)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Country{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Country{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_country_query_builder) DeleteByCode(ctx context.Context, db qb.Executor, Code string) (sql.Result, error) {
+	q.WhereCodeIs(Code)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_country_query_builder) UpdateByCode(ctx context.Context, db qb.Executor, Code string) (sql.Result, error) {
+	q.WhereCodeIs(Code)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_country_query_builder) OrderByAsc(column CountryColumn) CountryQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_country_query_builder) OrderByDesc(column CountryColumn) CountryQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_country_query_builder) OrderByRaw(fragment string, args ...any) CountryQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Country in order, so they have to match them.
+func (q *_dont_use_country_query_builder) SelectRaw(fragment string, args ...any) CountryQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Countrys keep their zero value.
+func (q *_dont_use_country_query_builder) Select(columns ...CountryColumn) CountryQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_country_query_builder) selectedColumns() []CountryColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []CountryColumn{CountryColumns.Code, CountryColumns.Name}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_country_query_builder) requireSelected(columns ...CountryColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_country_query_builder) target(m *Country, column CountryColumn) interface{} {
+	switch column {
+	case CountryColumns.Code:
+		return &m.Code
+	case CountryColumns.Name:
+		return &m.Name
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_country_query_builder) scanTargets(m *Country) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_country_query_builder) scanRow(row *sql.Row) (Country, error) {
+	var m Country
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Country{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_country_query_builder) scanRows(rows *sql.Rows) ([]Country, error) {
+	var records []Country
+	for rows.Next() {
+		var m Country
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_country_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_country_query_builder) joinColumns(columns []CountryColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Country{}, column) == nil {
+			return "", fmt.Errorf("unknown Country column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_country_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM countries", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_country_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE countries ")
+
+	var sets []string
+
+	if q.set.Code.isSet {
+		rhs := q.set.Code.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Code.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "code", rhs))
+	}
+
+	if q.set.Name.isSet {
+		rhs := q.set.Name.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Name.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "name", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_country_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM countries")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_country_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_country_query_builder) WhereRaw(fragment string, args ...any) CountryQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from countries filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_country_query_builder) subquery(column CountryColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM countries" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_country_query_builder) Or(fn func(b CountryQueryBuilder)) CountryQueryBuilder {
+	group := &_dont_use_country_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_country_query_builder) And(fn func(b CountryQueryBuilder)) CountryQueryBuilder {
+	group := &_dont_use_country_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+// WhereCode compares code using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_country_query_builder) WhereCode(operator qb.Operator, Code string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: operator, Argument: Code})
+	return q
+}
+
+func (q *_dont_use_country_query_builder) WhereCodeIs(Code string) CountryQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.Eq, Argument: Code})
+	return q
+}
+
+// WhereCodeIn matches rows whose code is one of Codes, an empty list matches nothing.
+func (q *_dont_use_country_query_builder) WhereCodeIn(Codes ...string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.In, Arguments: qb.Args(Codes)})
+	return q
+}
+
+// WhereCodeNotIn matches rows whose code is none of Codes, an empty list matches everything.
+func (q *_dont_use_country_query_builder) WhereCodeNotIn(Codes ...string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.NotIn, Arguments: qb.Args(Codes)})
+	return q
+}
+
+// WhereCodeLike matches code against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_country_query_builder) WhereCodeLike(pattern string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereCodeILike matches code against pattern ignoring case.
+func (q *_dont_use_country_query_builder) WhereCodeILike(pattern string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "code", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereCodeStartsWith matches rows whose code starts with prefix, prefix is matched literally.
+func (q *_dont_use_country_query_builder) WhereCodeStartsWith(prefix string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "code LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereCodeContains matches rows whose code contains substring, substring is matched literally.
+func (q *_dont_use_country_query_builder) WhereCodeContains(substring string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "code LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereName compares name using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_country_query_builder) WhereName(operator qb.Operator, Name string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: operator, Argument: Name})
+	return q
+}
+
+func (q *_dont_use_country_query_builder) WhereNameIs(Name string) CountryQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Eq, Argument: Name})
+	return q
+}
+
+// WhereNameIn matches rows whose name is one of Names, an empty list matches nothing.
+func (q *_dont_use_country_query_builder) WhereNameIn(Names ...string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.In, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameNotIn matches rows whose name is none of Names, an empty list matches everything.
+func (q *_dont_use_country_query_builder) WhereNameNotIn(Names ...string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.NotIn, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameLike matches name against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_country_query_builder) WhereNameLike(pattern string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNameILike matches name against pattern ignoring case.
+func (q *_dont_use_country_query_builder) WhereNameILike(pattern string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereNameStartsWith matches rows whose name starts with prefix, prefix is matched literally.
+func (q *_dont_use_country_query_builder) WhereNameStartsWith(prefix string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNameContains matches rows whose name contains substring, substring is matched literally.
+func (q *_dont_use_country_query_builder) WhereNameContains(substring string) CountryQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_country_query_builder) SetCode(Code string) CountryQueryBuilder {
+	q.mode = "update"
+	q.set.Code.argument = Code
+	q.set.Code.literal = ""
+	q.set.Code.isSet = true
+	return q
+}
+
+func (q *_dont_use_country_query_builder) SetName(Name string) CountryQueryBuilder {
+	q.mode = "update"
+	q.set.Name.argument = Name
+	q.set.Name.literal = ""
+	q.set.Name.isSet = true
+	return q
+}
+
+func (q *_dont_use_country_query_builder) Add(ctx context.Context, db qb.Executor, record *Country) error {
+	query := "INSERT INTO countries (code, name) VALUES ($1, $2)"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, record.Code, record.Name)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+func (q *_dont_use_country_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Country) error {
+	const chunkSize = 65535 / 2
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_country_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Country) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Code), q.bind(record.Name)}, ", ")+")")
+	}
+	query := "INSERT INTO countries (code, name) VALUES " + strings.Join(values, ", ")
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_country_query_builder) OnConflictUpdate(columns ...CountryColumn) CountryQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]CountryColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_country_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Country, conflictColumns ...CountryColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []CountryColumn{CountryColumns.Code}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []CountryColumn{CountryColumns.Code, CountryColumns.Name} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO countries (code, name) VALUES (" + strings.Join([]string{q.bind(record.Code), q.bind(record.Name)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Country needs conflictColumns to update the conflicting row, the primary key of Country is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	return err
+
+}
+
+	
\ No newline at end of file
@@ -0,0 +1,21 @@
+// Package postgres holds models generated with the postgres dialect, the tests
+// compare every statement the generated builders run against golden files.
+package postgres
+
+//go:generate go run ../../.. -dialect postgres -file $GOFILE
+
+// @querybuilder
+type User struct {
+	ID       int64 `qb:"pk,autoincrement"`
+	Name     string
+	Email    string `db:"email_address"`
+	Nickname *string
+	Posts    []Post `qb:"has_many"`
+}
+
+// @querybuilder
+type Membership struct {
+	UserID  int64 `qb:"pk"`
+	GroupID int64 `qb:"pk"`
+	Role    string
+}
@@ -0,0 +1,3112 @@
+// Code generated by modelgen. DO NOT EDIT
+
+package postgres
+
+import (
+    "fmt"
+    "strings"
+    "database/sql"
+	"context"
+	"iter"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+
+
+type UserQueryBuilder interface {
+	WhereIDIs(int64) UserQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) UserQueryBuilder
+	WhereIDIn(...int64) UserQueryBuilder
+	WhereIDNotIn(...int64) UserQueryBuilder
+
+	WhereIDGT(int64) UserQueryBuilder
+	WhereIDGE(int64) UserQueryBuilder
+	WhereIDLT(int64) UserQueryBuilder
+	WhereIDLE(int64) UserQueryBuilder
+
+	WhereNameIs(string) UserQueryBuilder
+	WhereName(operator qb.Operator, rhs string) UserQueryBuilder
+	WhereNameIn(...string) UserQueryBuilder
+	WhereNameNotIn(...string) UserQueryBuilder
+
+	WhereNameLike(pattern string) UserQueryBuilder
+	WhereNameILike(pattern string) UserQueryBuilder
+	WhereNameStartsWith(prefix string) UserQueryBuilder
+	WhereNameContains(substring string) UserQueryBuilder
+
+	WhereEmailIs(string) UserQueryBuilder
+	WhereEmail(operator qb.Operator, rhs string) UserQueryBuilder
+	WhereEmailIn(...string) UserQueryBuilder
+	WhereEmailNotIn(...string) UserQueryBuilder
+
+	WhereEmailLike(pattern string) UserQueryBuilder
+	WhereEmailILike(pattern string) UserQueryBuilder
+	WhereEmailStartsWith(prefix string) UserQueryBuilder
+	WhereEmailContains(substring string) UserQueryBuilder
+
+	WhereNicknameIs(*string) UserQueryBuilder
+	WhereNickname(operator qb.Operator, rhs *string) UserQueryBuilder
+	WhereNicknameIn(...*string) UserQueryBuilder
+	WhereNicknameNotIn(...*string) UserQueryBuilder
+
+	WhereNicknameLike(pattern string) UserQueryBuilder
+	WhereNicknameILike(pattern string) UserQueryBuilder
+	WhereNicknameStartsWith(prefix string) UserQueryBuilder
+	WhereNicknameContains(substring string) UserQueryBuilder
+
+	WhereNicknameIsNull() UserQueryBuilder
+	WhereNicknameIsNotNull() UserQueryBuilder
+
+	WhereRaw(fragment string, args ...any) UserQueryBuilder
+
+	Or(func(b UserQueryBuilder)) UserQueryBuilder
+	And(func(b UserQueryBuilder)) UserQueryBuilder
+
+	WherePostsHas(func(b PostQueryBuilder)) UserQueryBuilder
+	PreloadPosts() UserQueryBuilder
+
+	OrderByAsc(column UserColumn) UserQueryBuilder
+	OrderByDesc(column UserColumn) UserQueryBuilder
+	OrderByRaw(fragment string, args ...any) UserQueryBuilder
+
+	Select(columns ...UserColumn) UserQueryBuilder
+	SelectRaw(fragment string, args ...any) UserQueryBuilder
+
+	GroupBy(columns ...UserColumn) UserQueryBuilder
+	Having(fragment string, args ...any) UserQueryBuilder
+	HavingCount(operator qb.Operator, count int64) UserQueryBuilder
+
+	After(cursor string) UserQueryBuilder
+	Before(cursor string) UserQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (UserCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserPage, error)
+
+	Limit(int) UserQueryBuilder
+	Offset(int) UserQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (User, error)
+	Last(ctx context.Context, db qb.Executor) (User, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (User, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+
+	SetID(int64) UserQueryBuilder
+
+	SetName(string) UserQueryBuilder
+
+	SetEmail(string) UserQueryBuilder
+
+	SetNickname(*string) UserQueryBuilder
+	SetNicknameNull() UserQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *User) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*User) error
+
+	OnConflictUpdate(columns ...UserColumn) UserQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *User, conflictColumns ...UserColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]User, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]User, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[User, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]User) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]UserGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckName(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckEmail(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckNickname(ctx context.Context, db qb.Executor) ([]*string, error)
+
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() UserQueryBuilder
+}
+
+type _dont_use_user_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Name struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Email struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Nickname struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []UserColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []UserColumn
+
+	selected  []UserColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []User) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Users() UserQueryBuilder {
+	return &_dont_use_user_query_builder{}
+}
+
+func (q *_dont_use_user_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type UserColumn string
+
+var UserColumns = struct {
+	ID       UserColumn
+	Name     UserColumn
+	Email    UserColumn
+	Nickname UserColumn
+}{
+	ID:       UserColumn("id"),
+	Name:     UserColumn("name"),
+	Email:    UserColumn("email_address"),
+	Nickname: UserColumn("nickname"),
+}
+
+func (q *_dont_use_user_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_user_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_user_query_builder) Limit(l int) UserQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_user_query_builder) Offset(l int) UserQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q User) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.Name)
+	values = append(values, &q.Email)
+	values = append(values, &q.Nickname)
+
+	return values
+}
+
+func (q *_dont_use_user_query_builder) Debug() UserQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// UsersFromRows scans every column of User from rows and closes them.
+func UsersFromRows(rows *sql.Rows) ([]User, error) {
+	defer rows.Close()
+	var Users []User
+	for rows.Next() {
+		var m User
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.Name,
+
+			&m.Email,
+
+			&m.Nickname,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Users = append(Users, m)
+	}
+	return Users, rows.Err()
+}
+
+func UserFromRow(row *sql.Row) (User, error) {
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	var q User
+	err := row.Scan(
+		&q.ID,
+		&q.Name,
+		&q.Email,
+		&q.Nickname,
+	)
+	if err != nil {
+		return User{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_user_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]User, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_user_query_builder) fetch(ctx context.Context, db qb.Executor) ([]User, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_user_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []User) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_user_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (User, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return User{}, err
+	}
+	records := []User{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return User{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_user_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(User{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m User
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(User{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(User{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_user_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]User) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(UserColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// UserPage is a page of Users returned by Paginate, Page counts from 1.
+type UserPage struct {
+	Items    []User
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_user_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_user_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserPage, error) {
+	if page < 1 || perPage < 1 {
+		return UserPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return UserPage{}, err
+	}
+	result := UserPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// UserCursorPage is a page of Users returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type UserCursorPage struct {
+	Items []User
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_user_query_builder) After(cursor string) UserQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_user_query_builder) Before(cursor string) UserQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_user_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []UserColumn{UserColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_user_query_builder) encodeCursor(record User, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, UserColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_user_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record User
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, UserColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown User column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_user_query_builder) Page(ctx context.Context, db qb.Executor, size int) (UserCursorPage, error) {
+	if size <= 0 {
+		return UserCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return UserCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return UserCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]UserColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = UserColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return UserCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return UserCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := UserCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return UserCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return UserCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_user_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]User, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_user_query_builder) GroupBy(columns ...UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_user_query_builder) Having(fragment string, args ...any) UserQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_user_query_builder) HavingCount(operator qb.Operator, count int64) UserQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// UserGroup is a group of Users returned by FetchGroups, only the
+// GroupBy fields of the embedded User are set.
+type UserGroup struct {
+	User
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_user_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]UserGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []UserGroup
+	for rows.Next() {
+		var g UserGroup
+		if err := rows.Scan(append(q.scanTargets(&g.User), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_user_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_user_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckName returns the name of every matching row.
+func (q *_dont_use_user_query_builder) PluckName(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Name}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckEmail returns the email_address of every matching row.
+func (q *_dont_use_user_query_builder) PluckEmail(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Email}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckNickname returns the nickname of every matching row.
+func (q *_dont_use_user_query_builder) PluckNickname(ctx context.Context, db qb.Executor) ([]*string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Nickname}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v **string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_user_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM users" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_user_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_user_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM users" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
+}
+
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
+}
+
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
+}
+
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_user_query_builder) First(ctx context.Context, db qb.Executor) (User, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) Last(ctx context.Context, db qb.Executor) (User, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (User, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) OrderByAsc(column UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) OrderByDesc(column UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_user_query_builder) OrderByRaw(fragment string, args ...any) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of User in order, so they have to match them.
+func (q *_dont_use_user_query_builder) SelectRaw(fragment string, args ...any) UserQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Users keep their zero value.
+func (q *_dont_use_user_query_builder) Select(columns ...UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_user_query_builder) selectedColumns() []UserColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []UserColumn{UserColumns.ID, UserColumns.Name, UserColumns.Email, UserColumns.Nickname}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_user_query_builder) requireSelected(columns ...UserColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_user_query_builder) target(m *User, column UserColumn) interface{} {
+	switch column {
+	case UserColumns.ID:
+		return &m.ID
+	case UserColumns.Name:
+		return &m.Name
+	case UserColumns.Email:
+		return &m.Email
+	case UserColumns.Nickname:
+		return &m.Nickname
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_user_query_builder) scanTargets(m *User) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_user_query_builder) scanRow(row *sql.Row) (User, error) {
+	var m User
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return User{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_user_query_builder) scanRows(rows *sql.Rows) ([]User, error) {
+	var records []User
+	for rows.Next() {
+		var m User
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_user_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_user_query_builder) joinColumns(columns []UserColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&User{}, column) == nil {
+			return "", fmt.Errorf("unknown User column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_user_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM users", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_user_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE users ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.Name.isSet {
+		rhs := q.set.Name.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Name.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "name", rhs))
+	}
+
+	if q.set.Email.isSet {
+		rhs := q.set.Email.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Email.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "email_address", rhs))
+	}
+
+	if q.set.Nickname.isSet {
+		rhs := q.set.Nickname.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Nickname.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "nickname", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_user_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM users")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_user_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_user_query_builder) WhereRaw(fragment string, args ...any) UserQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from users filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_user_query_builder) subquery(column UserColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM users" + where, nil
+	}
+}
+
+// WherePostsHas keeps the Users with a Post matching the predicates fn adds.
+func (q *_dont_use_user_query_builder) WherePostsHas(fn func(b PostQueryBuilder)) UserQueryBuilder {
+	related := &_dont_use_post_query_builder{}
+	fn(related)
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Subquery: related.subquery(PostColumns.UserID)})
+	return q
+}
+
+// PreloadPosts loads the Posts of the rows Fetch, First, Last and FindBy return into their Posts
+// with one query, split when the keys exceed the bind parameter limit.
+func (q *_dont_use_user_query_builder) PreloadPosts() UserQueryBuilder {
+	q.preload = append(q.preload, q.preloadPosts)
+	return q
+}
+
+func (q *_dont_use_user_query_builder) preloadPosts(ctx context.Context, db qb.Executor, records []User) error {
+	seen := map[int64]bool{}
+	var keys []int64
+	for _, record := range records {
+		if key := record.ID; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	byKey := map[int64][]Post{}
+	for len(keys) > 0 {
+		n := min(len(keys), 65535)
+		related := &_dont_use_post_query_builder{}
+		related.where = append(related.where, qb.Predicate{Column: "user_id", Operator: qb.In, Arguments: qb.Args(keys[:n])})
+		rows, err := related.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			key := rows[i].UserID
+			byKey[key] = append(byKey[key], rows[i])
+		}
+		keys = keys[n:]
+	}
+	for i := range records {
+		records[i].Posts = byKey[records[i].ID]
+	}
+	return nil
+}
+
+// PostsQuery returns a query builder of the Posts of m.
+func (m User) PostsQuery() PostQueryBuilder {
+	return Posts().WhereUserIDIs(m.ID)
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_user_query_builder) Or(fn func(b UserQueryBuilder)) UserQueryBuilder {
+	group := &_dont_use_user_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_user_query_builder) And(fn func(b UserQueryBuilder)) UserQueryBuilder {
+	group := &_dont_use_user_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDGE(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDGT(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDLE(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDLT(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereID(operator qb.Operator, ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDIs(ID int64) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereIDIn(IDs ...int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereIDNotIn(IDs ...int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereName compares name using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereName(operator qb.Operator, Name string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: operator, Argument: Name})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNameIs(Name string) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Eq, Argument: Name})
+	return q
+}
+
+// WhereNameIn matches rows whose name is one of Names, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereNameIn(Names ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.In, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameNotIn matches rows whose name is none of Names, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereNameNotIn(Names ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.NotIn, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameLike matches name against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereNameLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNameILike matches name against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereNameILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereNameStartsWith matches rows whose name starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereNameStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNameContains matches rows whose name contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereNameContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereEmail compares email_address using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereEmail(operator qb.Operator, Email string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: operator, Argument: Email})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereEmailIs(Email string) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.Eq, Argument: Email})
+	return q
+}
+
+// WhereEmailIn matches rows whose email_address is one of Emails, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereEmailIn(Emails ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.In, Arguments: qb.Args(Emails)})
+	return q
+}
+
+// WhereEmailNotIn matches rows whose email_address is none of Emails, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereEmailNotIn(Emails ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.NotIn, Arguments: qb.Args(Emails)})
+	return q
+}
+
+// WhereEmailLike matches email_address against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereEmailLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereEmailILike matches email_address against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereEmailILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereEmailStartsWith matches rows whose email_address starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereEmailStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "email_address LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereEmailContains matches rows whose email_address contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereEmailContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "email_address LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereNickname compares nickname using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereNickname(operator qb.Operator, Nickname *string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: operator, Argument: Nickname})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIs(Nickname *string) UserQueryBuilder {
+	if Nickname == nil {
+		return q.WhereNicknameIsNull()
+	}
+
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Eq, Argument: Nickname})
+	return q
+}
+
+// WhereNicknameIn matches rows whose nickname is one of Nicknames, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereNicknameIn(Nicknames ...*string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.In, Arguments: qb.Args(Nicknames)})
+	return q
+}
+
+// WhereNicknameNotIn matches rows whose nickname is none of Nicknames, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereNicknameNotIn(Nicknames ...*string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.NotIn, Arguments: qb.Args(Nicknames)})
+	return q
+}
+
+// WhereNicknameLike matches nickname against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereNicknameLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNicknameILike matches nickname against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereNicknameILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereNicknameStartsWith matches rows whose nickname starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereNicknameStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "nickname LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNicknameContains matches rows whose nickname contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereNicknameContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "nickname LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIsNull() UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Is, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIsNotNull() UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.IsNot, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetID(ID int64) UserQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetName(Name string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Name.argument = Name
+	q.set.Name.literal = ""
+	q.set.Name.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetEmail(Email string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Email.argument = Email
+	q.set.Email.literal = ""
+	q.set.Email.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetNickname(Nickname *string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Nickname.argument = Nickname
+	q.set.Nickname.literal = ""
+	q.set.Nickname.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetNicknameNull() UserQueryBuilder {
+	q.mode = "update"
+	q.set.Nickname.literal = "NULL"
+	q.set.Nickname.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) Add(ctx context.Context, db qb.Executor, record *User) error {
+	query := "INSERT INTO users (name, email_address, nickname) VALUES ($1, $2, $3) RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return db.QueryRowContext(ctx, query, record.Name, record.Email, record.Nickname).Scan(&record.ID)
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from RETURNING assuming it yields the rows in the order
+// of VALUES, which postgres does in practice but doesn't document.
+func (q *_dont_use_user_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*User) error {
+	const chunkSize = 65535 / 3
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_user_query_builder) addMany(ctx context.Context, db qb.Executor, records []*User) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Name), q.bind(record.Email), q.bind(record.Nickname)}, ", ")+")")
+	}
+	query := "INSERT INTO users (name, email_address, nickname) VALUES " + strings.Join(values, ", ") + " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	// assumes the ids come back in the order of the VALUES rows, see AddMany.
+	n := 0
+	for ; rows.Next(); n++ {
+		if n < len(records) {
+			if err := rows.Scan(&records[n].ID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if n != len(records) {
+		return fmt.Errorf("insert of %d Users returned %d ids", len(records), n)
+	}
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_user_query_builder) OnConflictUpdate(columns ...UserColumn) UserQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]UserColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_user_query_builder) Upsert(ctx context.Context, db qb.Executor, record *User, conflictColumns ...UserColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []UserColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []UserColumn{UserColumns.Name, UserColumns.Email, UserColumns.Nickname} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO users (name, email_address, nickname) VALUES (" + strings.Join([]string{q.bind(record.Name), q.bind(record.Email), q.bind(record.Nickname)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of User needs conflictColumns to update the conflicting row, the primary key of User is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	query += " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.ID)
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+
+}
+
+
+
+
+type MembershipQueryBuilder interface {
+	WhereUserIDIs(int64) MembershipQueryBuilder
+	WhereUserID(operator qb.Operator, rhs int64) MembershipQueryBuilder
+	WhereUserIDIn(...int64) MembershipQueryBuilder
+	WhereUserIDNotIn(...int64) MembershipQueryBuilder
+
+	WhereUserIDGT(int64) MembershipQueryBuilder
+	WhereUserIDGE(int64) MembershipQueryBuilder
+	WhereUserIDLT(int64) MembershipQueryBuilder
+	WhereUserIDLE(int64) MembershipQueryBuilder
+
+	WhereGroupIDIs(int64) MembershipQueryBuilder
+	WhereGroupID(operator qb.Operator, rhs int64) MembershipQueryBuilder
+	WhereGroupIDIn(...int64) MembershipQueryBuilder
+	WhereGroupIDNotIn(...int64) MembershipQueryBuilder
+
+	WhereGroupIDGT(int64) MembershipQueryBuilder
+	WhereGroupIDGE(int64) MembershipQueryBuilder
+	WhereGroupIDLT(int64) MembershipQueryBuilder
+	WhereGroupIDLE(int64) MembershipQueryBuilder
+
+	WhereRoleIs(string) MembershipQueryBuilder
+	WhereRole(operator qb.Operator, rhs string) MembershipQueryBuilder
+	WhereRoleIn(...string) MembershipQueryBuilder
+	WhereRoleNotIn(...string) MembershipQueryBuilder
+
+	WhereRoleLike(pattern string) MembershipQueryBuilder
+	WhereRoleILike(pattern string) MembershipQueryBuilder
+	WhereRoleStartsWith(prefix string) MembershipQueryBuilder
+	WhereRoleContains(substring string) MembershipQueryBuilder
+
+	WhereRaw(fragment string, args ...any) MembershipQueryBuilder
+
+	Or(func(b MembershipQueryBuilder)) MembershipQueryBuilder
+	And(func(b MembershipQueryBuilder)) MembershipQueryBuilder
+
+	OrderByAsc(column MembershipColumn) MembershipQueryBuilder
+	OrderByDesc(column MembershipColumn) MembershipQueryBuilder
+	OrderByRaw(fragment string, args ...any) MembershipQueryBuilder
+
+	Select(columns ...MembershipColumn) MembershipQueryBuilder
+	SelectRaw(fragment string, args ...any) MembershipQueryBuilder
+
+	GroupBy(columns ...MembershipColumn) MembershipQueryBuilder
+	Having(fragment string, args ...any) MembershipQueryBuilder
+	HavingCount(operator qb.Operator, count int64) MembershipQueryBuilder
+
+	After(cursor string) MembershipQueryBuilder
+	Before(cursor string) MembershipQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (MembershipCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (MembershipPage, error)
+
+	Limit(int) MembershipQueryBuilder
+	Offset(int) MembershipQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Membership, error)
+	Last(ctx context.Context, db qb.Executor) (Membership, error)
+
+	FindByKey(ctx context.Context, db qb.Executor, key MembershipKey) (Membership, error)
+	DeleteByKey(ctx context.Context, db qb.Executor, key MembershipKey) (sql.Result, error)
+	UpdateByKey(ctx context.Context, db qb.Executor, key MembershipKey) (sql.Result, error)
+
+	SetUserID(int64) MembershipQueryBuilder
+
+	SetGroupID(int64) MembershipQueryBuilder
+
+	SetRole(string) MembershipQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Membership) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Membership) error
+
+	OnConflictUpdate(columns ...MembershipColumn) MembershipQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Membership, conflictColumns ...MembershipColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Membership, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Membership, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Membership, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Membership) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]MembershipGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckUserID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckGroupID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckRole(ctx context.Context, db qb.Executor) ([]string, error)
+
+	SumUserID(ctx context.Context, db qb.Executor) (int64, error)
+	MinUserID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxUserID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgUserID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SumGroupID(ctx context.Context, db qb.Executor) (int64, error)
+	MinGroupID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxGroupID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgGroupID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() MembershipQueryBuilder
+}
+
+type _dont_use_membership_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		UserID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		GroupID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Role struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []MembershipColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []MembershipColumn
+
+	selected  []MembershipColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Membership) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Memberships() MembershipQueryBuilder {
+	return &_dont_use_membership_query_builder{}
+}
+
+func (q *_dont_use_membership_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type MembershipColumn string
+
+var MembershipColumns = struct {
+	UserID  MembershipColumn
+	GroupID MembershipColumn
+	Role    MembershipColumn
+}{
+	UserID:  MembershipColumn("user_id"),
+	GroupID: MembershipColumn("group_id"),
+	Role:    MembershipColumn("role"),
+}
+
+func (q *_dont_use_membership_query_builder) getPlaceholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_membership_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_membership_query_builder) Limit(l int) MembershipQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) Offset(l int) MembershipQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Membership) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.UserID)
+	values = append(values, &q.GroupID)
+	values = append(values, &q.Role)
+
+	return values
+}
+
+func (q *_dont_use_membership_query_builder) Debug() MembershipQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// MembershipsFromRows scans every column of Membership from rows and closes them.
+func MembershipsFromRows(rows *sql.Rows) ([]Membership, error) {
+	defer rows.Close()
+	var Memberships []Membership
+	for rows.Next() {
+		var m Membership
+		err := rows.Scan(
+
+			&m.UserID,
+
+			&m.GroupID,
+
+			&m.Role,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Memberships = append(Memberships, m)
+	}
+	return Memberships, rows.Err()
+}
+
+func MembershipFromRow(row *sql.Row) (Membership, error) {
+	if row.Err() != nil {
+		return Membership{}, row.Err()
+	}
+	var q Membership
+	err := row.Scan(
+		&q.UserID,
+		&q.GroupID,
+		&q.Role,
+	)
+	if err != nil {
+		return Membership{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_membership_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_membership_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_membership_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Membership, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_membership_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Membership, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_membership_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Membership) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_membership_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Membership, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Membership{}, err
+	}
+	records := []Membership{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Membership{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_membership_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Membership, error] {
+	return func(yield func(Membership, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Membership{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Membership
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Membership{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Membership{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_membership_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]Membership) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(MembershipColumns.UserID, MembershipColumns.GroupID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "user_id ASC"}, {SQL: "group_id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Raw: "(user_id, group_id) > (?, ?)", Arguments: []any{last.UserID, last.GroupID}})
+	}
+}
+
+// MembershipPage is a page of Memberships returned by Paginate, Page counts from 1.
+type MembershipPage struct {
+	Items    []Membership
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_membership_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_membership_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (MembershipPage, error) {
+	if page < 1 || perPage < 1 {
+		return MembershipPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return MembershipPage{}, err
+	}
+	result := MembershipPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return MembershipPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// MembershipCursorPage is a page of Memberships returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type MembershipCursorPage struct {
+	Items []Membership
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_membership_query_builder) After(cursor string) MembershipQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_membership_query_builder) Before(cursor string) MembershipQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_membership_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []MembershipColumn{MembershipColumns.UserID, MembershipColumns.GroupID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_membership_query_builder) encodeCursor(record Membership, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, MembershipColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_membership_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record Membership
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, MembershipColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown Membership column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_membership_query_builder) Page(ctx context.Context, db qb.Executor, size int) (MembershipCursorPage, error) {
+	if size <= 0 {
+		return MembershipCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return MembershipCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return MembershipCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]MembershipColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = MembershipColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return MembershipCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return MembershipCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return MembershipCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := MembershipCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return MembershipCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return MembershipCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_membership_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Membership, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_membership_query_builder) GroupBy(columns ...MembershipColumn) MembershipQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_membership_query_builder) Having(fragment string, args ...any) MembershipQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_membership_query_builder) HavingCount(operator qb.Operator, count int64) MembershipQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// MembershipGroup is a group of Memberships returned by FetchGroups, only the
+// GroupBy fields of the embedded Membership are set.
+type MembershipGroup struct {
+	Membership
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_membership_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]MembershipGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []MembershipGroup
+	for rows.Next() {
+		var g MembershipGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Membership), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_membership_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckUserID returns the user_id of every matching row.
+func (q *_dont_use_membership_query_builder) PluckUserID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []MembershipColumn{MembershipColumns.UserID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckGroupID returns the group_id of every matching row.
+func (q *_dont_use_membership_query_builder) PluckGroupID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []MembershipColumn{MembershipColumns.GroupID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckRole returns the role of every matching row.
+func (q *_dont_use_membership_query_builder) PluckRole(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []MembershipColumn{MembershipColumns.Role}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_membership_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM memberships" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_membership_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_membership_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM memberships" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumUserID returns the sum of user_id over the matching rows, 0 if there are none.
+func (q *_dont_use_membership_query_builder) SumUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(user_id)", &sum)
+	return sum.V, err
+}
+
+// MinUserID returns the smallest user_id of the matching rows, 0 if there are none.
+func (q *_dont_use_membership_query_builder) MinUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(user_id)", &min)
+	return min.V, err
+}
+
+// MaxUserID returns the largest user_id of the matching rows, 0 if there are none.
+func (q *_dont_use_membership_query_builder) MaxUserID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(user_id)", &max)
+	return max.V, err
+}
+
+// AvgUserID returns the average of user_id over the matching rows, 0 if there are none.
+func (q *_dont_use_membership_query_builder) AvgUserID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(user_id)", &avg)
+	return avg.Float64, err
+}
+
+// SumGroupID returns the sum of group_id over the matching rows, 0 if there are none.
+func (q *_dont_use_membership_query_builder) SumGroupID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(group_id)", &sum)
+	return sum.V, err
+}
+
+// MinGroupID returns the smallest group_id of the matching rows, 0 if there are none.
+func (q *_dont_use_membership_query_builder) MinGroupID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(group_id)", &min)
+	return min.V, err
+}
+
+// MaxGroupID returns the largest group_id of the matching rows, 0 if there are none.
+func (q *_dont_use_membership_query_builder) MaxGroupID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(group_id)", &max)
+	return max.V, err
+}
+
+// AvgGroupID returns the average of group_id over the matching rows, 0 if there are none.
+func (q *_dont_use_membership_query_builder) AvgGroupID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(group_id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_membership_query_builder) First(ctx context.Context, db qb.Executor) (Membership, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "user_id ASC"}, {SQL: "group_id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Membership{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Membership{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_membership_query_builder) Last(ctx context.Context, db qb.Executor) (Membership, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "user_id DESC"}, {SQL: "group_id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Membership{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Membership{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+// MembershipKey holds the columns of the composite primary key of Membership.
+type MembershipKey struct {
+	UserID  int64
+	GroupID int64
+}
+
+func (q *_dont_use_membership_query_builder) whereKey(key MembershipKey) {
+	q.WhereUserIDIs(key.UserID)
+	q.WhereGroupIDIs(key.GroupID)
+
+}
+
+func (q *_dont_use_membership_query_builder) FindByKey(ctx context.Context, db qb.Executor, key MembershipKey) (Membership, error) {
+	q.whereKey(key)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Membership{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Membership{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_membership_query_builder) DeleteByKey(ctx context.Context, db qb.Executor, key MembershipKey) (sql.Result, error) {
+	q.whereKey(key)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_membership_query_builder) UpdateByKey(ctx context.Context, db qb.Executor, key MembershipKey) (sql.Result, error) {
+	q.whereKey(key)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_membership_query_builder) OrderByAsc(column MembershipColumn) MembershipQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) OrderByDesc(column MembershipColumn) MembershipQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_membership_query_builder) OrderByRaw(fragment string, args ...any) MembershipQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Membership in order, so they have to match them.
+func (q *_dont_use_membership_query_builder) SelectRaw(fragment string, args ...any) MembershipQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Memberships keep their zero value.
+func (q *_dont_use_membership_query_builder) Select(columns ...MembershipColumn) MembershipQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) selectedColumns() []MembershipColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []MembershipColumn{MembershipColumns.UserID, MembershipColumns.GroupID, MembershipColumns.Role}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_membership_query_builder) requireSelected(columns ...MembershipColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_membership_query_builder) target(m *Membership, column MembershipColumn) interface{} {
+	switch column {
+	case MembershipColumns.UserID:
+		return &m.UserID
+	case MembershipColumns.GroupID:
+		return &m.GroupID
+	case MembershipColumns.Role:
+		return &m.Role
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_membership_query_builder) scanTargets(m *Membership) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_membership_query_builder) scanRow(row *sql.Row) (Membership, error) {
+	var m Membership
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Membership{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_membership_query_builder) scanRows(rows *sql.Rows) ([]Membership, error) {
+	var records []Membership
+	for rows.Next() {
+		var m Membership
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_membership_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_membership_query_builder) joinColumns(columns []MembershipColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Membership{}, column) == nil {
+			return "", fmt.Errorf("unknown Membership column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_membership_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM memberships", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_membership_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE memberships ")
+
+	var sets []string
+
+	if q.set.UserID.isSet {
+		rhs := q.set.UserID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.UserID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "user_id", rhs))
+	}
+
+	if q.set.GroupID.isSet {
+		rhs := q.set.GroupID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.GroupID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "group_id", rhs))
+	}
+
+	if q.set.Role.isSet {
+		rhs := q.set.Role.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Role.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "role", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_membership_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM memberships")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_membership_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_membership_query_builder) WhereRaw(fragment string, args ...any) MembershipQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from memberships filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_membership_query_builder) subquery(column MembershipColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM memberships" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_membership_query_builder) Or(fn func(b MembershipQueryBuilder)) MembershipQueryBuilder {
+	group := &_dont_use_membership_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_membership_query_builder) And(fn func(b MembershipQueryBuilder)) MembershipQueryBuilder {
+	group := &_dont_use_membership_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereUserIDGE(UserID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Ge, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereUserIDGT(UserID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Gt, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereUserIDLE(UserID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Le, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereUserIDLT(UserID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Lt, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereGroupIDGE(GroupID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "group_id", Operator: qb.Ge, Argument: GroupID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereGroupIDGT(GroupID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "group_id", Operator: qb.Gt, Argument: GroupID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereGroupIDLE(GroupID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "group_id", Operator: qb.Le, Argument: GroupID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereGroupIDLT(GroupID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "group_id", Operator: qb.Lt, Argument: GroupID})
+	return q
+}
+
+// WhereUserID compares user_id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_membership_query_builder) WhereUserID(operator qb.Operator, UserID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: operator, Argument: UserID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereUserIDIs(UserID int64) MembershipQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.Eq, Argument: UserID})
+	return q
+}
+
+// WhereUserIDIn matches rows whose user_id is one of UserIDs, an empty list matches nothing.
+func (q *_dont_use_membership_query_builder) WhereUserIDIn(UserIDs ...int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.In, Arguments: qb.Args(UserIDs)})
+	return q
+}
+
+// WhereUserIDNotIn matches rows whose user_id is none of UserIDs, an empty list matches everything.
+func (q *_dont_use_membership_query_builder) WhereUserIDNotIn(UserIDs ...int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "user_id", Operator: qb.NotIn, Arguments: qb.Args(UserIDs)})
+	return q
+}
+
+// WhereGroupID compares group_id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_membership_query_builder) WhereGroupID(operator qb.Operator, GroupID int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "group_id", Operator: operator, Argument: GroupID})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereGroupIDIs(GroupID int64) MembershipQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "group_id", Operator: qb.Eq, Argument: GroupID})
+	return q
+}
+
+// WhereGroupIDIn matches rows whose group_id is one of GroupIDs, an empty list matches nothing.
+func (q *_dont_use_membership_query_builder) WhereGroupIDIn(GroupIDs ...int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "group_id", Operator: qb.In, Arguments: qb.Args(GroupIDs)})
+	return q
+}
+
+// WhereGroupIDNotIn matches rows whose group_id is none of GroupIDs, an empty list matches everything.
+func (q *_dont_use_membership_query_builder) WhereGroupIDNotIn(GroupIDs ...int64) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "group_id", Operator: qb.NotIn, Arguments: qb.Args(GroupIDs)})
+	return q
+}
+
+// WhereRole compares role using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_membership_query_builder) WhereRole(operator qb.Operator, Role string) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role", Operator: operator, Argument: Role})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) WhereRoleIs(Role string) MembershipQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "role", Operator: qb.Eq, Argument: Role})
+	return q
+}
+
+// WhereRoleIn matches rows whose role is one of Roles, an empty list matches nothing.
+func (q *_dont_use_membership_query_builder) WhereRoleIn(Roles ...string) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role", Operator: qb.In, Arguments: qb.Args(Roles)})
+	return q
+}
+
+// WhereRoleNotIn matches rows whose role is none of Roles, an empty list matches everything.
+func (q *_dont_use_membership_query_builder) WhereRoleNotIn(Roles ...string) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role", Operator: qb.NotIn, Arguments: qb.Args(Roles)})
+	return q
+}
+
+// WhereRoleLike matches role against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_membership_query_builder) WhereRoleLike(pattern string) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereRoleILike matches role against pattern ignoring case.
+func (q *_dont_use_membership_query_builder) WhereRoleILike(pattern string) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "role", Operator: qb.ILike, Argument: pattern})
+	return q
+}
+
+// WhereRoleStartsWith matches rows whose role starts with prefix, prefix is matched literally.
+func (q *_dont_use_membership_query_builder) WhereRoleStartsWith(prefix string) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "role LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereRoleContains matches rows whose role contains substring, substring is matched literally.
+func (q *_dont_use_membership_query_builder) WhereRoleContains(substring string) MembershipQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "role LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) SetUserID(UserID int64) MembershipQueryBuilder {
+	q.mode = "update"
+	q.set.UserID.argument = UserID
+	q.set.UserID.literal = ""
+	q.set.UserID.isSet = true
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) SetGroupID(GroupID int64) MembershipQueryBuilder {
+	q.mode = "update"
+	q.set.GroupID.argument = GroupID
+	q.set.GroupID.literal = ""
+	q.set.GroupID.isSet = true
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) SetRole(Role string) MembershipQueryBuilder {
+	q.mode = "update"
+	q.set.Role.argument = Role
+	q.set.Role.literal = ""
+	q.set.Role.isSet = true
+	return q
+}
+
+func (q *_dont_use_membership_query_builder) Add(ctx context.Context, db qb.Executor, record *Membership) error {
+	query := "INSERT INTO memberships (user_id, group_id, role) VALUES ($1, $2, $3)"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, record.UserID, record.GroupID, record.Role)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+func (q *_dont_use_membership_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Membership) error {
+	const chunkSize = 65535 / 3
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_membership_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Membership) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.UserID), q.bind(record.GroupID), q.bind(record.Role)}, ", ")+")")
+	}
+	query := "INSERT INTO memberships (user_id, group_id, role) VALUES " + strings.Join(values, ", ")
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_membership_query_builder) OnConflictUpdate(columns ...MembershipColumn) MembershipQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]MembershipColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_membership_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Membership, conflictColumns ...MembershipColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []MembershipColumn{MembershipColumns.UserID, MembershipColumns.GroupID}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []MembershipColumn{MembershipColumns.UserID, MembershipColumns.GroupID, MembershipColumns.Role} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO memberships (user_id, group_id, role) VALUES (" + strings.Join([]string{q.bind(record.UserID), q.bind(record.GroupID), q.bind(record.Role)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Membership needs conflictColumns to update the conflicting row, the primary key of Membership is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	return err
+
+}
+
+	
\ No newline at end of file
@@ -0,0 +1,11 @@
+package postgres
+
+//go:generate go run ../../.. -dialect postgres -file $GOFILE
+
+// @querybuilder
+type Post struct {
+	ID     int64 `qb:"pk,autoincrement"`
+	UserID int64 `qb:"belongs_to=User"`
+	Title  string
+	User   *User `qb:"belongs_to"`
+}
@@ -0,0 +1,522 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/internal/golden/recorder"
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+// userRows returns the rows of the users with ids from first to last.
+func userRows(first, last int64) [][]driver.Value {
+	var rows [][]driver.Value
+	for id := first; id <= last; id++ {
+		rows = append(rows, []driver.Value{id, "john", "john@example.com", nil})
+	}
+	return rows
+}
+
+func TestIterClosesRowsOnBreak(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Rows = userRows(1, 3)
+
+	var ids []int64
+	for user, err := range Users().Iter(context.Background(), db) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, user.ID)
+		if len(ids) == 2 {
+			break
+		}
+	}
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Fatalf("got ids %v, want [1 2]", ids)
+	}
+	if r.Closed != 1 {
+		t.Fatalf("rows closed %d times, want 1", r.Closed)
+	}
+}
+
+func TestIterYieldsRowsErr(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Rows = userRows(1, 2)
+	r.RowsErr = errors.New("connection reset")
+
+	var users int
+	var lastErr error
+	for _, err := range Users().Iter(context.Background(), db) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		users++
+	}
+	if users != 2 || lastErr != r.RowsErr {
+		t.Fatalf("got %d users and error %v, want 2 users and %v", users, lastErr, r.RowsErr)
+	}
+	if r.Closed != 1 {
+		t.Fatalf("rows closed %d times, want 1", r.Closed)
+	}
+}
+
+func TestFetchChecksRowsErr(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Rows = userRows(1, 2)
+	r.RowsErr = errors.New("connection reset")
+
+	if _, err := Users().Fetch(context.Background(), db); err != r.RowsErr {
+		t.Fatalf("got error %v, want %v", err, r.RowsErr)
+	}
+	if r.Closed != 1 {
+		t.Fatalf("rows closed %d times, want 1", r.Closed)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{userRows(1, 2), userRows(3, 4), userRows(5, 5)}
+
+	var batches [][]int64
+	err := Users().WhereNameIs("john").Chunk(context.Background(), db, 2, func(users []User) error {
+		var ids []int64
+		for _, user := range users {
+			ids = append(ids, user.ID)
+		}
+		batches = append(batches, ids)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(batches) != "[[1 2] [3 4] [5]]" {
+		t.Fatalf("got batches %v", batches)
+	}
+	recorder.AssertStatements(t, r,
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 ORDER BY id ASC LIMIT 2 [john]",
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 AND id > $2 ORDER BY id ASC LIMIT 2 [john 2]",
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 AND id > $2 ORDER BY id ASC LIMIT 2 [john 4]",
+	)
+}
+
+func TestChunkLeavesBuilderAsIs(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{userRows(1, 2), userRows(3, 3)}
+
+	q := Users().WhereNameIs("john").Offset(5)
+	if err := q.Chunk(context.Background(), db, 2, func([]User) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	q.Fetch(context.Background(), db)
+	recorder.AssertStatements(t, r,
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 ORDER BY id ASC LIMIT 2 [john]",
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 AND id > $2 ORDER BY id ASC LIMIT 2 [john 2]",
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 OFFSET 5 [john]",
+	)
+}
+
+func TestChunkWithoutPrimaryKey(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	for _, q := range []UserQueryBuilder{Users().Select(UserColumns.Name), Users().SelectRaw("name")} {
+		if err := q.Chunk(context.Background(), db, 2, func([]User) error { return nil }); err == nil {
+			t.Fatal("expected an error for chunks without the primary key")
+		}
+	}
+	if len(r.Statements) != 0 {
+		t.Fatalf("got %d queries, want none", len(r.Statements))
+	}
+}
+
+func TestChunkStopsOnError(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{userRows(1, 2), userRows(3, 4)}
+
+	stop := errors.New("stop")
+	calls := 0
+	err := Users().Chunk(context.Background(), db, 2, func([]User) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Fatalf("got error %v after %d calls, want %v after 1", err, calls, stop)
+	}
+}
+
+func TestPage(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	ctx := context.Background()
+
+	// the first page reads one row more than size to know there is a next one.
+	r.Results = [][][]driver.Value{userRows(1, 3)}
+	first, err := Users().WhereNameIs("john").OrderByDesc(UserColumns.Name).Page(ctx, db, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Items) != 2 || first.Next == "" || first.Prev != "" {
+		t.Fatalf("first page got %d items, next %q and prev %q", len(first.Items), first.Next, first.Prev)
+	}
+
+	r.Results = [][][]driver.Value{userRows(3, 3)}
+	second, err := Users().WhereNameIs("john").OrderByDesc(UserColumns.Name).After(first.Next).Page(ctx, db, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second.Items) != 1 || second.Next != "" || second.Prev == "" {
+		t.Fatalf("second page got %d items, next %q and prev %q", len(second.Items), second.Next, second.Prev)
+	}
+
+	// rows before a cursor are read in reverse.
+	r.Results = [][][]driver.Value{{userRows(2, 2)[0], userRows(1, 1)[0]}}
+	back, err := Users().WhereNameIs("john").OrderByDesc(UserColumns.Name).Before(second.Prev).Page(ctx, db, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(back.Items[0].ID, back.Items[1].ID) != "1 2" || back.Next == "" || back.Prev != "" {
+		t.Fatalf("previous page got %v, next %q and prev %q", back.Items, back.Next, back.Prev)
+	}
+
+	recorder.AssertStatements(t, r,
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 ORDER BY name DESC, id ASC LIMIT 3 [john]",
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 AND ((name < $2) OR (name = $3 AND id > $4)) ORDER BY name DESC, id ASC LIMIT 3 [john john john 2]",
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 AND ((name > $2) OR (name = $3 AND id < $4)) ORDER BY name ASC, id DESC LIMIT 3 [john john john 3]",
+	)
+}
+
+func TestPageInvalidCursor(t *testing.T) {
+	db, _ := recorder.Open()
+	defer db.Close()
+	if _, err := Users().After("garbage").Page(context.Background(), db, 10); err == nil {
+		t.Fatal("expected an error for an invalid cursor")
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{{{int64(5)}}, userRows(3, 4)}
+
+	page, err := Users().WhereNameIs("john").OrderByAsc(UserColumns.ID).Paginate(context.Background(), db, 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 2 || page.Total != 5 || page.Page != 2 || page.PerPage != 2 || page.LastPage != 3 {
+		t.Fatalf("got page %+v", page)
+	}
+	recorder.AssertStatements(t, r,
+		"SELECT COUNT(*) FROM users WHERE name = $1 [john]",
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 ORDER BY id ASC LIMIT 2 OFFSET 2 [john]",
+	)
+}
+
+func TestPaginatePastLastPage(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{{{int64(0)}}}
+
+	page, err := Users().Paginate(context.Background(), db, 3, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 0 || page.LastPage != 1 || len(r.Statements) != 1 {
+		t.Fatalf("got page %+v after %d queries", page, len(r.Statements))
+	}
+}
+
+func TestPreloadPosts(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{
+		userRows(1, 3),
+		{{int64(10), int64(1), "first"}, {int64(11), int64(3), "second"}, {int64(12), int64(1), "third"}},
+	}
+
+	users, err := Users().WhereNameIs("john").PreloadPosts().Fetch(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, user := range users {
+		var titles []string
+		for _, post := range user.Posts {
+			titles = append(titles, post.Title)
+		}
+		got = append(got, fmt.Sprintf("%d:%v", user.ID, titles))
+	}
+	if fmt.Sprint(got) != "[1:[first third] 2:[] 3:[second]]" {
+		t.Fatalf("got posts %v", got)
+	}
+	recorder.AssertStatements(t, r,
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 [john]",
+		"SELECT id, user_id, title FROM posts WHERE user_id IN ($1, $2, $3) [1 2 3]",
+	)
+}
+
+func TestPreloadUser(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{
+		{{int64(10), int64(2), "first"}, {int64(11), int64(2), "second"}, {int64(12), int64(5), "third"}},
+		userRows(2, 2),
+	}
+
+	posts, err := Posts().PreloadUser().Fetch(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if posts[0].User == nil || posts[0].User != posts[1].User || posts[0].User.ID != 2 || posts[2].User != nil {
+		t.Fatalf("got users %v, %v and %v", posts[0].User, posts[1].User, posts[2].User)
+	}
+	recorder.AssertStatements(t, r,
+		"SELECT id, user_id, title FROM posts []",
+		"SELECT id, name, email_address, nickname FROM users WHERE id IN ($1, $2) [2 5]",
+	)
+}
+
+func TestPreloadNullableForeignKeys(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{
+		{{int64(10), nil, int64(1), int64(100)}, {int64(11), int64(1), int64(2), int64(50)}, {int64(12), int64(2), nil, int64(20)}},
+		{{int64(1), "alice"}, {int64(2), "bob"}},
+		{{int64(1), "alice"}, {int64(2), "bob"}},
+	}
+
+	transfers, err := Transfers().PreloadFrom().PreloadTo().Fetch(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, transfer := range transfers {
+		from, to := "-", "-"
+		if transfer.From != nil {
+			from = transfer.From.Name
+		}
+		if transfer.To != nil {
+			to = transfer.To.Name
+		}
+		got = append(got, fmt.Sprintf("%d:%s>%s", transfer.ID, from, to))
+	}
+	if fmt.Sprint(got) != "[10:->alice 11:alice>bob 12:bob>-]" {
+		t.Fatalf("got transfers %v", got)
+	}
+	recorder.AssertStatements(t, r,
+		"SELECT id, from_id, to_id, amount FROM transfers []",
+		"SELECT id, name FROM accounts WHERE id IN ($1, $2) [1 2]",
+		"SELECT id, name FROM accounts WHERE id IN ($1, $2) [1 2]",
+	)
+}
+
+func TestPreloadThroughNullableForeignKey(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{
+		{{int64(1), "alice"}, {int64(2), "bob"}},
+		{{int64(11), int64(1), int64(2), int64(50)}, {int64(12), int64(2), nil, int64(20)}, {int64(13), int64(1), nil, int64(5)}},
+		{{int64(11), int64(1), int64(2), int64(50)}},
+	}
+
+	accounts, err := Accounts().PreloadSent().PreloadReceived().Fetch(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts[0].Sent) != 2 || len(accounts[1].Sent) != 1 || len(accounts[0].Received) != 0 || len(accounts[1].Received) != 1 {
+		t.Fatalf("got accounts %+v", accounts)
+	}
+	recorder.AssertStatements(t, r,
+		"SELECT id, name FROM accounts []",
+		"SELECT id, from_id, to_id, amount FROM transfers WHERE from_id IN ($1, $2) [1 2]",
+		"SELECT id, from_id, to_id, amount FROM transfers WHERE to_id IN ($1, $2) [1 2]",
+	)
+}
+
+func TestNullableForeignKeyQuery(t *testing.T) {
+	query, err := Transfer{}.FromQuery().SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query != "SELECT id, name FROM accounts WHERE 1 = 0" {
+		t.Fatalf("got %q", query)
+	}
+}
+
+func TestPreloadSingleRow(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	ctx := context.Background()
+	r.Results = [][][]driver.Value{
+		userRows(1, 1),
+		{{int64(10), int64(1), "first"}, {int64(12), int64(1), "third"}},
+		{{int64(10), int64(1), "first"}},
+		userRows(1, 1),
+	}
+
+	user, err := Users().PreloadPosts().First(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(user.Posts) != 2 {
+		t.Fatalf("got posts %v", user.Posts)
+	}
+	post, err := Posts().PreloadUser().FindByID(ctx, db, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.User == nil || post.User.ID != 1 {
+		t.Fatalf("got user %v", post.User)
+	}
+	recorder.AssertStatements(t, r,
+		"SELECT id, name, email_address, nickname FROM users ORDER BY id ASC LIMIT 1 []",
+		"SELECT id, user_id, title FROM posts WHERE user_id IN ($1) [1]",
+		"SELECT id, user_id, title FROM posts WHERE id = $1 LIMIT 1 [10]",
+		"SELECT id, name, email_address, nickname FROM users WHERE id IN ($1) [1]",
+	)
+}
+
+func TestPreloadNothing(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	if _, err := Users().PreloadPosts().Fetch(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Statements) != 1 {
+		t.Fatalf("got %d queries, want only the users one", len(r.Statements))
+	}
+}
+
+func TestAddManyBackfillsIDs(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Rows = [][]driver.Value{{int64(7)}, {int64(8)}}
+
+	users := []*User{{Name: "a"}, {Name: "b"}}
+	if err := Users().AddMany(context.Background(), db, users); err != nil {
+		t.Fatal(err)
+	}
+	if users[0].ID != 7 || users[1].ID != 8 {
+		t.Fatalf("got ids %d and %d, want 7 and 8", users[0].ID, users[1].ID)
+	}
+}
+
+func TestAddManyShortReturning(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Rows = [][]driver.Value{{int64(7)}}
+
+	users := []*User{{Name: "a"}, {Name: "b"}}
+	if err := Users().AddMany(context.Background(), db, users); err == nil {
+		t.Fatal("expected an error when fewer ids than records come back")
+	}
+}
+
+func TestPageTwice(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	ctx := context.Background()
+
+	r.Rows = userRows(1, 2)
+	cursor, err := Users().OrderByAsc(UserColumns.Name).Page(ctx, db, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Statements = nil
+
+	q := Users().OrderByAsc(UserColumns.Name).Offset(3).After(cursor.Next)
+	for range 2 {
+		if _, err := q.Page(ctx, db, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	page := "SELECT id, name, email_address, nickname FROM users WHERE ((name > $1) OR (name = $2 AND id > $3)) ORDER BY name ASC, id ASC LIMIT 2 [john john 1]"
+	recorder.AssertStatements(t, r, page, page)
+}
+
+func TestPageWithoutSortColumns(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	for _, q := range []UserQueryBuilder{
+		Users().Select(UserColumns.Name).OrderByAsc(UserColumns.Name),
+		Users().Select(UserColumns.ID, UserColumns.Email).OrderByAsc(UserColumns.Name),
+		Users().SelectRaw("id, name").OrderByAsc(UserColumns.Name),
+	} {
+		if _, err := q.Page(context.Background(), db, 2); err == nil {
+			t.Fatal("expected an error for a page without its sort columns")
+		}
+	}
+	if len(r.Statements) != 0 {
+		t.Fatalf("got %d queries, want none", len(r.Statements))
+	}
+}
+
+func TestPageOrderByRaw(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	if _, err := Users().OrderByAsc(UserColumns.Name).OrderByRaw("length(name)").Page(context.Background(), db, 10); err == nil {
+		t.Fatal("expected an error for a page ordered by a raw fragment")
+	}
+	recorder.AssertStatements(t, r)
+}
+
+func TestPaginateGroups(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+	r.Results = [][][]driver.Value{{{int64(3)}}, userRows(1, 2)}
+
+	page, err := Users().GroupBy(UserColumns.ID, UserColumns.Name, UserColumns.Email, UserColumns.Nickname).
+		HavingCount(qb.Gt, 1).OrderByAsc(UserColumns.ID).Paginate(context.Background(), db, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 2 || page.Total != 3 || page.LastPage != 2 {
+		t.Fatalf("got page %+v", page)
+	}
+	recorder.AssertStatements(t, r,
+		"SELECT COUNT(*) FROM (SELECT id, name, email_address, nickname FROM users GROUP BY id, name, email_address, nickname HAVING COUNT(*) > $1) AS grouped [1]",
+		"SELECT id, name, email_address, nickname FROM users GROUP BY id, name, email_address, nickname HAVING COUNT(*) > $1 ORDER BY id ASC LIMIT 2 [1]",
+	)
+}
+
+func TestPluckLeavesBuilderAsIs(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	q := Users().WhereNameIs("john")
+	if _, err := q.PluckID(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+	q.Fetch(context.Background(), db)
+	recorder.AssertStatements(t, r,
+		"SELECT id FROM users WHERE name = $1 [john]",
+		"SELECT id, name, email_address, nickname FROM users WHERE name = $1 [john]",
+	)
+}
+
+func TestFetchGroupsLeavesBuilderAsIs(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	q := Users().GroupBy(UserColumns.Name)
+	if _, err := q.FetchGroups(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+	q.SelectRaw("name, MAX(id)").Rows(context.Background(), db)
+	recorder.AssertStatements(t, r,
+		"SELECT name, COUNT(*) FROM users GROUP BY name []",
+		"SELECT name, MAX(id) FROM users GROUP BY name []",
+	)
+}
@@ -0,0 +1,332 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/internal/golden/recorder"
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+func TestUserStatements(t *testing.T) {
+	nickname := "jj"
+	recorder.CheckGolden(t, "user.golden", []recorder.Case{
+		{Name: "fetch", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Fetch(ctx, db)
+		}},
+		{Name: "fetch where", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").WhereID(qb.Gt, 10).OrderByDesc(UserColumns.Name).Limit(10).Offset(20).Fetch(ctx, db)
+		}},
+		{Name: "fetch several predicates per column", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereIDGT(18).WhereIDLT(65).WhereIDGE(20).WhereIDLE(60).Fetch(ctx, db)
+		}},
+		{Name: "fetch or group", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereIDGT(10).Or(func(b UserQueryBuilder) {
+				b.WhereNameIs("john").And(func(b UserQueryBuilder) {
+					b.WhereNameIs("jane").WhereNicknameIsNotNull()
+				})
+			}).WhereEmailIs("john@example.com").Fetch(ctx, db)
+		}},
+		{Name: "fetch empty group", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Or(func(b UserQueryBuilder) {}).Fetch(ctx, db)
+		}},
+		{Name: "fetch in", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").WhereIDIn(1, 2, 3).WhereEmailNotIn("a@example.com", "b@example.com").Fetch(ctx, db)
+		}},
+		{Name: "fetch in empty", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereIDIn().WhereEmailNotIn().Fetch(ctx, db)
+		}},
+		{Name: "fetch patterns", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameLike("jo%").WhereNameILike("JO%").WhereEmailStartsWith("50%_off!").WhereNicknameContains("_j").Fetch(ctx, db)
+		}},
+		{Name: "fetch operators", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereID(qb.Ne, 1).WhereID(qb.In, 2).WhereName(qb.NotLike, "j%").Fetch(ctx, db)
+		}},
+		{Name: "fetch where raw", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereIDGT(1).WhereRaw("date(created_at) = ? OR data ?? 'key'", "2024-01-01").WhereNameIs("john").Fetch(ctx, db)
+		}},
+		{Name: "count", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").Count(ctx, db)
+		}},
+		{Name: "exists", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereEmailIs("john@example.com").Exists(ctx, db)
+		}},
+		{Name: "aggregates", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereIDGT(10).SumID(ctx, db)
+			Users().MinID(ctx, db)
+			Users().MaxID(ctx, db)
+			Users().WhereNicknameIsNotNull().AvgID(ctx, db)
+		}},
+		{Name: "fetch groups", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereIDGT(10).GroupBy(UserColumns.Name).HavingCount(qb.Gt, 1).OrderByAsc(UserColumns.Name).FetchGroups(ctx, db)
+		}},
+		{Name: "group rows", Run: func(ctx context.Context, db qb.Executor) {
+			rows, err := Users().
+				SelectRaw("name, MAX(id)").
+				GroupBy(UserColumns.Name, UserColumns.Nickname).
+				Having("MAX(id) > ?", 5).
+				Rows(ctx, db)
+			if err == nil {
+				qb.Collect(rows, func(g *struct {
+					Name  string
+					MaxID int64
+				}) []any {
+					return []any{&g.Name, &g.MaxID}
+				})
+			}
+		}},
+		{Name: "pluck", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").OrderByAsc(UserColumns.ID).PluckID(ctx, db)
+			Users().SelectRaw("id, name").PluckEmail(ctx, db)
+		}},
+		{Name: "where posts has", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").WherePostsHas(func(b PostQueryBuilder) {
+				b.WhereTitleContains("go").WhereIDGT(10)
+			}).Fetch(ctx, db)
+		}},
+		{Name: "posts query", Run: func(ctx context.Context, db qb.Executor) {
+			User{ID: 7}.PostsQuery().OrderByDesc(PostColumns.ID).Fetch(ctx, db)
+		}},
+		{Name: "fetch select", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Select(UserColumns.Email, UserColumns.ID).WhereNameIs("john").Fetch(ctx, db)
+		}},
+		{Name: "fetch raw fragments", Run: func(ctx context.Context, db qb.Executor) {
+			Users().
+				Select(UserColumns.ID, UserColumns.Nickname).
+				SelectRaw("id").
+				SelectRaw("COALESCE(nickname, ?) AS nickname", "none").
+				WhereRaw("date(created_at) = ?", "2024-01-01").
+				WhereNameIs("john").
+				OrderByRaw("position(? in name)", "j").
+				OrderByAsc(UserColumns.ID).
+				Fetch(ctx, db)
+		}},
+		{Name: "fetch where null", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNicknameIsNull().WhereEmailIs("john@example.com").Fetch(ctx, db)
+		}},
+		{Name: "fetch where pointer", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNicknameIs(&nickname).Fetch(ctx, db)
+		}},
+		{Name: "first", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").First(ctx, db)
+		}},
+		{Name: "last", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Last(ctx, db)
+		}},
+		{Name: "find by id", Run: func(ctx context.Context, db qb.Executor) {
+			Users().FindByID(ctx, db, 1)
+		}},
+		{Name: "update", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereNameIs("john").WhereEmailIs("john@example.com").SetNicknameNull().SetName("jane").Update(ctx, db)
+		}},
+		{Name: "update or group", Run: func(ctx context.Context, db qb.Executor) {
+			Users().SetName("jane").Or(func(b UserQueryBuilder) {
+				b.WhereIDIs(1).WhereIDIs(2)
+			}).Update(ctx, db)
+		}},
+		{Name: "update in", Run: func(ctx context.Context, db qb.Executor) {
+			Users().SetName("jane").WhereIDIn(1, 2).Update(ctx, db)
+		}},
+		{Name: "update by id", Run: func(ctx context.Context, db qb.Executor) {
+			Users().SetEmail("jane@example.com").UpdateByID(ctx, db, 1)
+		}},
+		{Name: "delete", Run: func(ctx context.Context, db qb.Executor) {
+			Users().WhereEmailIs("john@example.com").WhereNicknameIsNotNull().Delete(ctx, db)
+		}},
+		{Name: "delete or group", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Or(func(b UserQueryBuilder) {
+				b.WhereNameIs("john").WhereNicknameIsNull()
+			}).Delete(ctx, db)
+		}},
+		{Name: "delete by id", Run: func(ctx context.Context, db qb.Executor) {
+			Users().DeleteByID(ctx, db, 1)
+		}},
+		{Name: "add", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Add(ctx, db, &User{Name: "john", Email: "john@example.com"})
+		}},
+		{Name: "add many", Run: func(ctx context.Context, db qb.Executor) {
+			Users().AddMany(ctx, db, []*User{
+				{Name: "john", Email: "john@example.com"},
+				{Name: "jane", Email: "jane@example.com", Nickname: &nickname},
+			})
+		}},
+		{Name: "upsert", Run: func(ctx context.Context, db qb.Executor) {
+			Users().Upsert(ctx, db, &User{Name: "john", Email: "john@example.com"}, UserColumns.Email)
+		}},
+		{Name: "upsert update columns", Run: func(ctx context.Context, db qb.Executor) {
+			Users().OnConflictUpdate(UserColumns.Nickname).Upsert(ctx, db, &User{Name: "john", Email: "john@example.com"}, UserColumns.Email)
+		}},
+	})
+}
+
+func TestPostStatements(t *testing.T) {
+	recorder.CheckGolden(t, "post.golden", []recorder.Case{
+		{Name: "where user has", Run: func(ctx context.Context, db qb.Executor) {
+			Posts().WhereTitleIs("hello").WhereUserHas(func(b UserQueryBuilder) {
+				b.WhereNameIs("john")
+			}).Delete(ctx, db)
+		}},
+		{Name: "where user has nothing", Run: func(ctx context.Context, db qb.Executor) {
+			Posts().WhereUserHas(func(UserQueryBuilder) {}).Count(ctx, db)
+		}},
+		{Name: "user query", Run: func(ctx context.Context, db qb.Executor) {
+			Post{UserID: 7}.UserQuery().First(ctx, db)
+		}},
+	})
+}
+
+// TestArticleStatements covers the struct tags, Views is readonly so it's only
+// ever selected and Draft isn't a column.
+func TestArticleStatements(t *testing.T) {
+	article := &Article{Title: "hello", Body: "world", Views: 3, Draft: true}
+	recorder.CheckGolden(t, "article.golden", []recorder.Case{
+		{Name: "fetch", Run: func(ctx context.Context, db qb.Executor) {
+			Articles().WhereTitleIs("hello").WhereViewsGT(10).Fetch(ctx, db)
+		}},
+		{Name: "add", Run: func(ctx context.Context, db qb.Executor) {
+			Articles().Add(ctx, db, article)
+		}},
+		{Name: "add many", Run: func(ctx context.Context, db qb.Executor) {
+			Articles().AddMany(ctx, db, []*Article{article, article})
+		}},
+		{Name: "upsert", Run: func(ctx context.Context, db qb.Executor) {
+			Articles().Upsert(ctx, db, article, ArticleColumns.Title)
+		}},
+		{Name: "update", Run: func(ctx context.Context, db qb.Executor) {
+			Articles().SetTitle("bye").SetBody("moon").WhereIDIs(1).Update(ctx, db)
+		}},
+	})
+}
+
+func TestReadonlyColumnHasNoSetter(t *testing.T) {
+	if _, ok := reflect.TypeFor[ArticleQueryBuilder]().MethodByName("SetViews"); ok {
+		t.Fatal("ArticleQueryBuilder has SetViews but views is readonly")
+	}
+}
+
+// TestKeyStatements covers the primary keys of the models that don't tag one.
+func TestKeyStatements(t *testing.T) {
+	recorder.CheckGolden(t, "keys.golden", []recorder.Case{
+		{Name: "add integer id", Run: func(ctx context.Context, db qb.Executor) {
+			Tags().Add(ctx, db, &Tag{Name: "go"})
+		}},
+		{Name: "find by integer id", Run: func(ctx context.Context, db qb.Executor) {
+			Tags().FindByID(ctx, db, 1)
+		}},
+		{Name: "add string id", Run: func(ctx context.Context, db qb.Executor) {
+			Tokens().Add(ctx, db, &Token{ID: "abc", UserID: 1})
+		}},
+		{Name: "find by string id", Run: func(ctx context.Context, db qb.Executor) {
+			Tokens().FindByID(ctx, db, "abc")
+		}},
+		{Name: "find by annotated key", Run: func(ctx context.Context, db qb.Executor) {
+			Countrys().FindByCode(ctx, db, "nl")
+		}},
+		{Name: "delete by annotated key", Run: func(ctx context.Context, db qb.Executor) {
+			Countrys().DeleteByCode(ctx, db, "nl")
+		}},
+		{Name: "upsert on annotated key", Run: func(ctx context.Context, db qb.Executor) {
+			Countrys().Upsert(ctx, db, &Country{Code: "nl", Name: "Netherlands"})
+		}},
+	})
+}
+
+func TestMembershipStatements(t *testing.T) {
+	key := MembershipKey{UserID: 1, GroupID: 2}
+	recorder.CheckGolden(t, "membership.golden", []recorder.Case{
+		{Name: "first", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().First(ctx, db)
+		}},
+		{Name: "last", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().WhereRoleIs("admin").Last(ctx, db)
+		}},
+		{Name: "find by key", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().FindByKey(ctx, db, key)
+		}},
+		{Name: "chunk", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().WhereRoleIs("admin").Chunk(ctx, db, 100, func([]Membership) error { return nil })
+		}},
+		{Name: "update by key", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().SetRole("owner").UpdateByKey(ctx, db, key)
+		}},
+		{Name: "delete by key", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().DeleteByKey(ctx, db, key)
+		}},
+		{Name: "add", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().Add(ctx, db, &Membership{UserID: 1, GroupID: 2, Role: "admin"})
+		}},
+		{Name: "upsert", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().Upsert(ctx, db, &Membership{UserID: 1, GroupID: 2, Role: "admin"})
+		}},
+		{Name: "upsert do nothing", Run: func(ctx context.Context, db qb.Executor) {
+			Memberships().OnConflictUpdate().Upsert(ctx, db, &Membership{UserID: 1, GroupID: 2, Role: "admin"})
+		}},
+	})
+}
+
+func TestAddManyChunks(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	// 3 insert columns, 65535 bind parameters fit 21845 rows in a statement.
+	users := make([]*User, 21846)
+	ids := make([][]driver.Value, len(users))
+	for i := range users {
+		users[i] = &User{}
+		ids[i] = []driver.Value{int64(i + 1)}
+	}
+	r.Results = [][][]driver.Value{ids[:21845], ids[21845:]}
+	if err := Users().AddMany(context.Background(), db, users); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Statements) != 2 {
+		t.Fatalf("got %d statements, want 2", len(r.Statements))
+	}
+	if got := len(r.Statements[0].Args); got != 65535 {
+		t.Errorf("first statement has %d arguments, want 65535", got)
+	}
+	if got := len(r.Statements[1].Args); got != 3 {
+		t.Errorf("second statement has %d arguments, want 3", got)
+	}
+	if users[21845].ID != 21846 {
+		t.Errorf("last user got id %d, want 21846", users[21845].ID)
+	}
+}
+
+func TestInvalidOperator(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	_, err := Users().WhereName("= 'x' OR 1 = 1 --", "john").Fetch(context.Background(), db)
+	if err == nil {
+		t.Fatal("expected an error for an undeclared operator")
+	}
+	if len(r.Statements) != 0 {
+		t.Errorf("query with an undeclared operator was sent to the database: %v", r.Statements)
+	}
+}
+
+func TestUnknownSelectedColumn(t *testing.T) {
+	_, err := Users().Select(UserColumn("password")).SQL()
+	if err == nil {
+		t.Fatal("expected an error for a column User doesn't have")
+	}
+}
+
+func TestFetchGroupsWithoutGroupBy(t *testing.T) {
+	if _, err := Users().FetchGroups(context.Background(), nil); err == nil {
+		t.Fatal("expected an error without GroupBy columns")
+	}
+}
+
+func TestUpsertOfAutoIncrementKey(t *testing.T) {
+	db, r := recorder.Open()
+	defer db.Close()
+
+	if err := Users().Upsert(context.Background(), db, &User{Name: "john", Email: "john@example.com"}); err == nil {
+		t.Fatal("expected an error for an upsert without a conflict target the insert can hit")
+	}
+	recorder.AssertStatements(t, r)
+}
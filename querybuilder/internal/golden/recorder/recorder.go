@@ -0,0 +1,175 @@
+// Package recorder is a database/sql driver for the golden tests, it records the
+// statements the generated query builders run instead of running them.
+package recorder
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// Statement is a recorded query and its arguments.
+type Statement struct {
+	Query string
+	Args  []driver.Value
+}
+
+func (s Statement) String() string {
+	return fmt.Sprintf("%s %v", s.Query, s.Args)
+}
+
+// Recorder records the statements it's asked to run. Queries return the next
+// element of Results, or Rows once Results run out, followed by RowsErr. They
+// return no rows by default.
+type Recorder struct {
+	Statements []Statement
+
+	Results [][][]driver.Value
+	Rows    [][]driver.Value
+	RowsErr error
+	// Closed counts the closed query results.
+	Closed int
+	// LastInsertID is the id Exec reports, it's the id of the last inserted row.
+	LastInsertID int64
+}
+
+// Open returns a database whose statements are recorded by the returned Recorder.
+func Open() (*sql.DB, *Recorder) {
+	r := &Recorder{}
+	return sql.OpenDB(r), r
+}
+
+func (r *Recorder) Connect(context.Context) (driver.Conn, error) { return conn{r}, nil }
+func (r *Recorder) Driver() driver.Driver                        { return r }
+func (r *Recorder) Open(string) (driver.Conn, error)             { return conn{r}, nil }
+
+type conn struct{ r *Recorder }
+
+func (c conn) Prepare(query string) (driver.Stmt, error) {
+	return stmt{r: c.r, query: query}, nil
+}
+func (c conn) Close() error              { return nil }
+func (c conn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type stmt struct {
+	r     *Recorder
+	query string
+}
+
+func (s stmt) Close() error  { return nil }
+func (s stmt) NumInput() int { return -1 }
+
+func (s stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.r.Statements = append(s.r.Statements, Statement{Query: s.query, Args: args})
+	return result{s.r.LastInsertID}, nil
+}
+
+func (s stmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.r.Statements = append(s.r.Statements, Statement{Query: s.query, Args: args})
+	values := s.r.Rows
+	if len(s.r.Results) > 0 {
+		values, s.r.Results = s.r.Results[0], s.r.Results[1:]
+	}
+	return &rows{r: s.r, values: values}, nil
+}
+
+type result struct{ lastInsertID int64 }
+
+func (r result) LastInsertId() (int64, error) { return r.lastInsertID, nil }
+func (r result) RowsAffected() (int64, error) { return 0, nil }
+
+type rows struct {
+	r      *Recorder
+	values [][]driver.Value
+	next   int
+}
+
+func (rows *rows) Columns() []string {
+	if len(rows.values) == 0 {
+		return nil
+	}
+	return make([]string, len(rows.values[0]))
+}
+
+func (rows *rows) Close() error {
+	rows.r.Closed++
+	return nil
+}
+
+func (rows *rows) Next(dest []driver.Value) error {
+	if rows.next == len(rows.values) {
+		if rows.r.RowsErr != nil {
+			return rows.r.RowsErr
+		}
+		return io.EOF
+	}
+	copy(dest, rows.values[rows.next])
+	rows.next++
+	return nil
+}
+
+// Case is a named piece of code whose statements end up in a golden file.
+type Case struct {
+	Name string
+	Run  func(ctx context.Context, db qb.Executor)
+}
+
+// CheckGolden runs cases and compares their statements with testdata/golden,
+// go test -update rewrites the file instead.
+func CheckGolden(t *testing.T, golden string, cases []Case) {
+	t.Helper()
+	db, r := Open()
+	defer db.Close()
+
+	var out bytes.Buffer
+	for _, c := range cases {
+		r.Statements = nil
+		c.Run(context.Background(), db)
+		fmt.Fprintf(&out, "== %s\n", c.Name)
+		for _, stmt := range r.Statements {
+			fmt.Fprintf(&out, "%s\n%v\n", stmt.Query, stmt.Args)
+		}
+	}
+
+	path := filepath.Join("testdata", golden)
+	if *update {
+		if err := os.WriteFile(path, out.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out.Bytes(), want) {
+		t.Errorf("statements differ from %s, run go test -update to refresh it\ngot:\n%s\nwant:\n%s", path, out.Bytes(), want)
+	}
+}
+
+// AssertStatements checks that r recorded exactly the want statements, each one
+// formatted as the query followed by its arguments.
+func AssertStatements(t *testing.T, r *Recorder, want ...string) {
+	t.Helper()
+	if len(r.Statements) != len(want) {
+		t.Errorf("got %d statements, want %d", len(r.Statements), len(want))
+	}
+	for i, stmt := range r.Statements {
+		if i >= len(want) {
+			t.Errorf("unexpected statement %d: %s", i, stmt)
+		} else if got := stmt.String(); got != want[i] {
+			t.Errorf("statement %d:\ngot  %s\nwant %s", i, got, want[i])
+		}
+	}
+}
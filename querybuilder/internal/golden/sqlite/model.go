@@ -0,0 +1,19 @@
+// Package sqlite holds models generated with the sqlite dialect, the tests compare
+// every statement the generated builders run against golden files.
+package sqlite
+
+//go:generate go run ../../.. -dialect sqlite -file $GOFILE
+
+// @querybuilder
+type User struct {
+	ID       int64 `qb:"pk,autoincrement"`
+	Name     string
+	Email    string `db:"email_address"`
+	Nickname *string
+}
+
+// @querybuilder
+type Setting struct {
+	Key   string
+	Value string
+}
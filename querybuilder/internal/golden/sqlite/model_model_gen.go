@@ -0,0 +1,2665 @@
+// Code generated by modelgen. DO NOT EDIT
+
+package sqlite
+
+import (
+    "fmt"
+    "strings"
+    "database/sql"
+	"context"
+	"iter"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
+)
+
+
+
+type UserQueryBuilder interface {
+	WhereIDIs(int64) UserQueryBuilder
+	WhereID(operator qb.Operator, rhs int64) UserQueryBuilder
+	WhereIDIn(...int64) UserQueryBuilder
+	WhereIDNotIn(...int64) UserQueryBuilder
+
+	WhereIDGT(int64) UserQueryBuilder
+	WhereIDGE(int64) UserQueryBuilder
+	WhereIDLT(int64) UserQueryBuilder
+	WhereIDLE(int64) UserQueryBuilder
+
+	WhereNameIs(string) UserQueryBuilder
+	WhereName(operator qb.Operator, rhs string) UserQueryBuilder
+	WhereNameIn(...string) UserQueryBuilder
+	WhereNameNotIn(...string) UserQueryBuilder
+
+	WhereNameLike(pattern string) UserQueryBuilder
+	WhereNameILike(pattern string) UserQueryBuilder
+	WhereNameStartsWith(prefix string) UserQueryBuilder
+	WhereNameContains(substring string) UserQueryBuilder
+
+	WhereEmailIs(string) UserQueryBuilder
+	WhereEmail(operator qb.Operator, rhs string) UserQueryBuilder
+	WhereEmailIn(...string) UserQueryBuilder
+	WhereEmailNotIn(...string) UserQueryBuilder
+
+	WhereEmailLike(pattern string) UserQueryBuilder
+	WhereEmailILike(pattern string) UserQueryBuilder
+	WhereEmailStartsWith(prefix string) UserQueryBuilder
+	WhereEmailContains(substring string) UserQueryBuilder
+
+	WhereNicknameIs(*string) UserQueryBuilder
+	WhereNickname(operator qb.Operator, rhs *string) UserQueryBuilder
+	WhereNicknameIn(...*string) UserQueryBuilder
+	WhereNicknameNotIn(...*string) UserQueryBuilder
+
+	WhereNicknameLike(pattern string) UserQueryBuilder
+	WhereNicknameILike(pattern string) UserQueryBuilder
+	WhereNicknameStartsWith(prefix string) UserQueryBuilder
+	WhereNicknameContains(substring string) UserQueryBuilder
+
+	WhereNicknameIsNull() UserQueryBuilder
+	WhereNicknameIsNotNull() UserQueryBuilder
+
+	WhereRaw(fragment string, args ...any) UserQueryBuilder
+
+	Or(func(b UserQueryBuilder)) UserQueryBuilder
+	And(func(b UserQueryBuilder)) UserQueryBuilder
+
+	OrderByAsc(column UserColumn) UserQueryBuilder
+	OrderByDesc(column UserColumn) UserQueryBuilder
+	OrderByRaw(fragment string, args ...any) UserQueryBuilder
+
+	Select(columns ...UserColumn) UserQueryBuilder
+	SelectRaw(fragment string, args ...any) UserQueryBuilder
+
+	GroupBy(columns ...UserColumn) UserQueryBuilder
+	Having(fragment string, args ...any) UserQueryBuilder
+	HavingCount(operator qb.Operator, count int64) UserQueryBuilder
+
+	After(cursor string) UserQueryBuilder
+	Before(cursor string) UserQueryBuilder
+	Page(ctx context.Context, db qb.Executor, size int) (UserCursorPage, error)
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserPage, error)
+
+	Limit(int) UserQueryBuilder
+	Offset(int) UserQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (User, error)
+	Last(ctx context.Context, db qb.Executor) (User, error)
+
+	FindByID(ctx context.Context, db qb.Executor, ID int64) (User, error)
+	DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+	UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error)
+
+	SetID(int64) UserQueryBuilder
+
+	SetName(string) UserQueryBuilder
+
+	SetEmail(string) UserQueryBuilder
+
+	SetNickname(*string) UserQueryBuilder
+	SetNicknameNull() UserQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *User) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*User) error
+
+	OnConflictUpdate(columns ...UserColumn) UserQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *User, conflictColumns ...UserColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]User, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]User, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[User, error]
+	Chunk(ctx context.Context, db qb.Executor, size int, fn func([]User) error) error
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]UserGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckID(ctx context.Context, db qb.Executor) ([]int64, error)
+	PluckName(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckEmail(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckNickname(ctx context.Context, db qb.Executor) ([]*string, error)
+
+	SumID(ctx context.Context, db qb.Executor) (int64, error)
+	MinID(ctx context.Context, db qb.Executor) (int64, error)
+	MaxID(ctx context.Context, db qb.Executor) (int64, error)
+	AvgID(ctx context.Context, db qb.Executor) (float64, error)
+
+	SQL() (string, error)
+
+	Debug() UserQueryBuilder
+}
+
+type _dont_use_user_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		ID struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Name struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Email struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Nickname struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []UserColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []UserColumn
+
+	selected  []UserColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []User) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Users() UserQueryBuilder {
+	return &_dont_use_user_query_builder{}
+}
+
+func (q *_dont_use_user_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type UserColumn string
+
+var UserColumns = struct {
+	ID       UserColumn
+	Name     UserColumn
+	Email    UserColumn
+	Nickname UserColumn
+}{
+	ID:       UserColumn("id"),
+	Name:     UserColumn("name"),
+	Email:    UserColumn("email_address"),
+	Nickname: UserColumn("nickname"),
+}
+
+func (q *_dont_use_user_query_builder) getPlaceholder() string {
+	return "?"
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_user_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_user_query_builder) Limit(l int) UserQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_user_query_builder) Offset(l int) UserQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q User) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.ID)
+	values = append(values, &q.Name)
+	values = append(values, &q.Email)
+	values = append(values, &q.Nickname)
+
+	return values
+}
+
+func (q *_dont_use_user_query_builder) Debug() UserQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// UsersFromRows scans every column of User from rows and closes them.
+func UsersFromRows(rows *sql.Rows) ([]User, error) {
+	defer rows.Close()
+	var Users []User
+	for rows.Next() {
+		var m User
+		err := rows.Scan(
+
+			&m.ID,
+
+			&m.Name,
+
+			&m.Email,
+
+			&m.Nickname,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Users = append(Users, m)
+	}
+	return Users, rows.Err()
+}
+
+func UserFromRow(row *sql.Row) (User, error) {
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	var q User
+	err := row.Scan(
+		&q.ID,
+		&q.Name,
+		&q.Email,
+		&q.Nickname,
+	)
+	if err != nil {
+		return User{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_user_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]User, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_user_query_builder) fetch(ctx context.Context, db qb.Executor) ([]User, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_user_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []User) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_user_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (User, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return User{}, err
+	}
+	records := []User{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return User{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_user_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(User{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m User
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(User{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(User{}, err)
+		}
+	}
+}
+
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *_dont_use_user_query_builder) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]User) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected(UserColumns.ID); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		q.where = append(where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: last.ID})
+	}
+}
+
+// UserPage is a page of Users returned by Paginate, Page counts from 1.
+type UserPage struct {
+	Items    []User
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_user_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_user_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (UserPage, error) {
+	if page < 1 || perPage < 1 {
+		return UserPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return UserPage{}, err
+	}
+	result := UserPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// UserCursorPage is a page of Users returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type UserCursorPage struct {
+	Items []User
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *_dont_use_user_query_builder) After(cursor string) UserQueryBuilder {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *_dont_use_user_query_builder) Before(cursor string) UserQueryBuilder {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *_dont_use_user_query_builder) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []UserColumn{UserColumns.ID} {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *_dont_use_user_query_builder) encodeCursor(record User, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, UserColumn(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *_dont_use_user_query_builder) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record User
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, UserColumn(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown User column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *_dont_use_user_query_builder) Page(ctx context.Context, db qb.Executor, size int) (UserCursorPage, error) {
+	if size <= 0 {
+		return UserCursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return UserCursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return UserCursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]UserColumn, len(keys))
+	for i, key := range keys {
+		columns[i] = UserColumn(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return UserCursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return UserCursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return UserCursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := UserCursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return UserCursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return UserCursorPage{}, err
+		}
+	}
+	return page, nil
+}
+
+func (q *_dont_use_user_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]User, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_user_query_builder) GroupBy(columns ...UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_user_query_builder) Having(fragment string, args ...any) UserQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_user_query_builder) HavingCount(operator qb.Operator, count int64) UserQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// UserGroup is a group of Users returned by FetchGroups, only the
+// GroupBy fields of the embedded User are set.
+type UserGroup struct {
+	User
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_user_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]UserGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []UserGroup
+	for rows.Next() {
+		var g UserGroup
+		if err := rows.Scan(append(q.scanTargets(&g.User), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_user_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckID returns the id of every matching row.
+func (q *_dont_use_user_query_builder) PluckID(ctx context.Context, db qb.Executor) ([]int64, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.ID}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *int64) []any { return []any{v} })
+}
+
+// PluckName returns the name of every matching row.
+func (q *_dont_use_user_query_builder) PluckName(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Name}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckEmail returns the email_address of every matching row.
+func (q *_dont_use_user_query_builder) PluckEmail(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Email}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckNickname returns the nickname of every matching row.
+func (q *_dont_use_user_query_builder) PluckNickname(ctx context.Context, db qb.Executor) ([]*string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []UserColumn{UserColumns.Nickname}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v **string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_user_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM users" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_user_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_user_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM users" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+// SumID returns the sum of id over the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) SumID(ctx context.Context, db qb.Executor) (int64, error) {
+	var sum sql.Null[int64]
+	err := q.aggregate(ctx, db, "SUM(id)", &sum)
+	return sum.V, err
+}
+
+// MinID returns the smallest id of the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) MinID(ctx context.Context, db qb.Executor) (int64, error) {
+	var min sql.Null[int64]
+	err := q.aggregate(ctx, db, "MIN(id)", &min)
+	return min.V, err
+}
+
+// MaxID returns the largest id of the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) MaxID(ctx context.Context, db qb.Executor) (int64, error) {
+	var max sql.Null[int64]
+	err := q.aggregate(ctx, db, "MAX(id)", &max)
+	return max.V, err
+}
+
+// AvgID returns the average of id over the matching rows, 0 if there are none.
+func (q *_dont_use_user_query_builder) AvgID(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG(id)", &avg)
+	return avg.Float64, err
+}
+
+func (q *_dont_use_user_query_builder) First(ctx context.Context, db qb.Executor) (User, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id ASC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) Last(ctx context.Context, db qb.Executor) (User, error) {
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{{SQL: "id DESC"}}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) FindByID(ctx context.Context, db qb.Executor, ID int64) (User, error) {
+	q.WhereIDIs(ID)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return User{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return User{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_user_query_builder) DeleteByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) UpdateByID(ctx context.Context, db qb.Executor, ID int64) (sql.Result, error) {
+	q.WhereIDIs(ID)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_user_query_builder) OrderByAsc(column UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) OrderByDesc(column UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_user_query_builder) OrderByRaw(fragment string, args ...any) UserQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of User in order, so they have to match them.
+func (q *_dont_use_user_query_builder) SelectRaw(fragment string, args ...any) UserQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Users keep their zero value.
+func (q *_dont_use_user_query_builder) Select(columns ...UserColumn) UserQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_user_query_builder) selectedColumns() []UserColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []UserColumn{UserColumns.ID, UserColumns.Name, UserColumns.Email, UserColumns.Nickname}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_user_query_builder) requireSelected(columns ...UserColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_user_query_builder) target(m *User, column UserColumn) interface{} {
+	switch column {
+	case UserColumns.ID:
+		return &m.ID
+	case UserColumns.Name:
+		return &m.Name
+	case UserColumns.Email:
+		return &m.Email
+	case UserColumns.Nickname:
+		return &m.Nickname
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_user_query_builder) scanTargets(m *User) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_user_query_builder) scanRow(row *sql.Row) (User, error) {
+	var m User
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return User{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_user_query_builder) scanRows(rows *sql.Rows) ([]User, error) {
+	var records []User
+	for rows.Next() {
+		var m User
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_user_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_user_query_builder) joinColumns(columns []UserColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&User{}, column) == nil {
+			return "", fmt.Errorf("unknown User column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_user_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM users", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_user_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE users ")
+
+	var sets []string
+
+	if q.set.ID.isSet {
+		rhs := q.set.ID.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.ID.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "id", rhs))
+	}
+
+	if q.set.Name.isSet {
+		rhs := q.set.Name.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Name.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "name", rhs))
+	}
+
+	if q.set.Email.isSet {
+		rhs := q.set.Email.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Email.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "email_address", rhs))
+	}
+
+	if q.set.Nickname.isSet {
+		rhs := q.set.Nickname.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Nickname.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "nickname", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_user_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM users")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_user_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_user_query_builder) WhereRaw(fragment string, args ...any) UserQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from users filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_user_query_builder) subquery(column UserColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM users" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_user_query_builder) Or(fn func(b UserQueryBuilder)) UserQueryBuilder {
+	group := &_dont_use_user_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_user_query_builder) And(fn func(b UserQueryBuilder)) UserQueryBuilder {
+	group := &_dont_use_user_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDGE(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Ge, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDGT(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Gt, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDLE(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Le, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDLT(ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Lt, Argument: ID})
+	return q
+}
+
+// WhereID compares id using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereID(operator qb.Operator, ID int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: operator, Argument: ID})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereIDIs(ID int64) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.Eq, Argument: ID})
+	return q
+}
+
+// WhereIDIn matches rows whose id is one of IDs, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereIDIn(IDs ...int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.In, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereIDNotIn matches rows whose id is none of IDs, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereIDNotIn(IDs ...int64) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "id", Operator: qb.NotIn, Arguments: qb.Args(IDs)})
+	return q
+}
+
+// WhereName compares name using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereName(operator qb.Operator, Name string) UserQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereNameILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(name) LIKE LOWER(?)", Arguments: []any{Name}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: operator, Argument: Name})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNameIs(Name string) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Eq, Argument: Name})
+	return q
+}
+
+// WhereNameIn matches rows whose name is one of Names, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereNameIn(Names ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.In, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameNotIn matches rows whose name is none of Names, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereNameNotIn(Names ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.NotIn, Arguments: qb.Args(Names)})
+	return q
+}
+
+// WhereNameLike matches name against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereNameLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "name", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNameILike matches name against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereNameILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(name) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereNameStartsWith matches rows whose name starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereNameStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNameContains matches rows whose name contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereNameContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "name LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereEmail compares email_address using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereEmail(operator qb.Operator, Email string) UserQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereEmailILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(email_address) LIKE LOWER(?)", Arguments: []any{Email}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: operator, Argument: Email})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereEmailIs(Email string) UserQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.Eq, Argument: Email})
+	return q
+}
+
+// WhereEmailIn matches rows whose email_address is one of Emails, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereEmailIn(Emails ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.In, Arguments: qb.Args(Emails)})
+	return q
+}
+
+// WhereEmailNotIn matches rows whose email_address is none of Emails, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereEmailNotIn(Emails ...string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.NotIn, Arguments: qb.Args(Emails)})
+	return q
+}
+
+// WhereEmailLike matches email_address against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereEmailLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "email_address", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereEmailILike matches email_address against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereEmailILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(email_address) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereEmailStartsWith matches rows whose email_address starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereEmailStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "email_address LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereEmailContains matches rows whose email_address contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereEmailContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "email_address LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereNickname compares nickname using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_user_query_builder) WhereNickname(operator qb.Operator, Nickname *string) UserQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereNicknameILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(nickname) LIKE LOWER(?)", Arguments: []any{Nickname}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: operator, Argument: Nickname})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIs(Nickname *string) UserQueryBuilder {
+	if Nickname == nil {
+		return q.WhereNicknameIsNull()
+	}
+
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Eq, Argument: Nickname})
+	return q
+}
+
+// WhereNicknameIn matches rows whose nickname is one of Nicknames, an empty list matches nothing.
+func (q *_dont_use_user_query_builder) WhereNicknameIn(Nicknames ...*string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.In, Arguments: qb.Args(Nicknames)})
+	return q
+}
+
+// WhereNicknameNotIn matches rows whose nickname is none of Nicknames, an empty list matches everything.
+func (q *_dont_use_user_query_builder) WhereNicknameNotIn(Nicknames ...*string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.NotIn, Arguments: qb.Args(Nicknames)})
+	return q
+}
+
+// WhereNicknameLike matches nickname against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_user_query_builder) WhereNicknameLike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereNicknameILike matches nickname against pattern ignoring case.
+func (q *_dont_use_user_query_builder) WhereNicknameILike(pattern string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(nickname) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereNicknameStartsWith matches rows whose nickname starts with prefix, prefix is matched literally.
+func (q *_dont_use_user_query_builder) WhereNicknameStartsWith(prefix string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "nickname LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereNicknameContains matches rows whose nickname contains substring, substring is matched literally.
+func (q *_dont_use_user_query_builder) WhereNicknameContains(substring string) UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "nickname LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIsNull() UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.Is, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) WhereNicknameIsNotNull() UserQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "nickname", Operator: qb.IsNot, Literal: "NULL"})
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetID(ID int64) UserQueryBuilder {
+	q.mode = "update"
+	q.set.ID.argument = ID
+	q.set.ID.literal = ""
+	q.set.ID.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetName(Name string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Name.argument = Name
+	q.set.Name.literal = ""
+	q.set.Name.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetEmail(Email string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Email.argument = Email
+	q.set.Email.literal = ""
+	q.set.Email.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetNickname(Nickname *string) UserQueryBuilder {
+	q.mode = "update"
+	q.set.Nickname.argument = Nickname
+	q.set.Nickname.literal = ""
+	q.set.Nickname.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) SetNicknameNull() UserQueryBuilder {
+	q.mode = "update"
+	q.set.Nickname.literal = "NULL"
+	q.set.Nickname.isSet = true
+	return q
+}
+
+func (q *_dont_use_user_query_builder) Add(ctx context.Context, db qb.Executor, record *User) error {
+	query := "INSERT INTO users (name, email_address, nickname) VALUES (?, ?, ?)"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	res, err := db.ExecContext(ctx, query, record.Name, record.Email, record.Nickname)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	record.ID = int64(id)
+
+	return nil
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+// The ids are back-filled from LastInsertId assuming the rows of a statement get
+// consecutive ids, which SQLite does in practice but doesn't document.
+func (q *_dont_use_user_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*User) error {
+	const chunkSize = 999 / 3
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_user_query_builder) addMany(ctx context.Context, db qb.Executor, records []*User) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Name), q.bind(record.Email), q.bind(record.Nickname)}, ", ")+")")
+	}
+	query := "INSERT INTO users (name, email_address, nickname) VALUES " + strings.Join(values, ", ")
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	res, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	// SQLite reports the id of the last row, rows of a single statement get consecutive ids.
+	id -= int64(len(records) - 1)
+	for i, record := range records {
+		record.ID = int64(id + int64(i))
+	}
+
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_user_query_builder) OnConflictUpdate(columns ...UserColumn) UserQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]UserColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_user_query_builder) Upsert(ctx context.Context, db qb.Executor, record *User, conflictColumns ...UserColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []UserColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []UserColumn{UserColumns.Name, UserColumns.Email, UserColumns.Nickname} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO users (name, email_address, nickname) VALUES (" + strings.Join([]string{q.bind(record.Name), q.bind(record.Email), q.bind(record.Nickname)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of User needs conflictColumns to update the conflicting row, the primary key of User is not inserted")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	query += " RETURNING id"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.ID)
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+
+}
+
+
+
+
+type SettingQueryBuilder interface {
+	WhereKeyIs(string) SettingQueryBuilder
+	WhereKey(operator qb.Operator, rhs string) SettingQueryBuilder
+	WhereKeyIn(...string) SettingQueryBuilder
+	WhereKeyNotIn(...string) SettingQueryBuilder
+
+	WhereKeyLike(pattern string) SettingQueryBuilder
+	WhereKeyILike(pattern string) SettingQueryBuilder
+	WhereKeyStartsWith(prefix string) SettingQueryBuilder
+	WhereKeyContains(substring string) SettingQueryBuilder
+
+	WhereValueIs(string) SettingQueryBuilder
+	WhereValue(operator qb.Operator, rhs string) SettingQueryBuilder
+	WhereValueIn(...string) SettingQueryBuilder
+	WhereValueNotIn(...string) SettingQueryBuilder
+
+	WhereValueLike(pattern string) SettingQueryBuilder
+	WhereValueILike(pattern string) SettingQueryBuilder
+	WhereValueStartsWith(prefix string) SettingQueryBuilder
+	WhereValueContains(substring string) SettingQueryBuilder
+
+	WhereRaw(fragment string, args ...any) SettingQueryBuilder
+
+	Or(func(b SettingQueryBuilder)) SettingQueryBuilder
+	And(func(b SettingQueryBuilder)) SettingQueryBuilder
+
+	OrderByAsc(column SettingColumn) SettingQueryBuilder
+	OrderByDesc(column SettingColumn) SettingQueryBuilder
+	OrderByRaw(fragment string, args ...any) SettingQueryBuilder
+
+	Select(columns ...SettingColumn) SettingQueryBuilder
+	SelectRaw(fragment string, args ...any) SettingQueryBuilder
+
+	GroupBy(columns ...SettingColumn) SettingQueryBuilder
+	Having(fragment string, args ...any) SettingQueryBuilder
+	HavingCount(operator qb.Operator, count int64) SettingQueryBuilder
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) (SettingPage, error)
+
+	Limit(int) SettingQueryBuilder
+	Offset(int) SettingQueryBuilder
+
+	getPlaceholder() string
+
+	First(ctx context.Context, db qb.Executor) (Setting, error)
+
+	SetKey(string) SettingQueryBuilder
+
+	SetValue(string) SettingQueryBuilder
+
+	Add(ctx context.Context, db qb.Executor, record *Setting) error
+
+	AddMany(ctx context.Context, db qb.Executor, records []*Setting) error
+
+	OnConflictUpdate(columns ...SettingColumn) SettingQueryBuilder
+	Upsert(ctx context.Context, db qb.Executor, record *Setting, conflictColumns ...SettingColumn) error
+
+	Update(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Delete(ctx context.Context, db qb.Executor) (sql.Result, error)
+
+	Fetch(ctx context.Context, db qb.Executor) ([]Setting, error)
+	FindAll(ctx context.Context, db qb.Executor) ([]Setting, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[Setting, error]
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]SettingGroup, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	PluckKey(ctx context.Context, db qb.Executor) ([]string, error)
+	PluckValue(ctx context.Context, db qb.Executor) ([]string, error)
+
+	SQL() (string, error)
+
+	Debug() SettingQueryBuilder
+}
+
+type _dont_use_setting_query_builder struct {
+	mode string
+
+	where []qb.Predicate
+
+	set struct {
+		Key struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+
+		Value struct {
+			argument interface{}
+			literal  string
+			isSet    bool
+		}
+	}
+
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after    string
+	before   string
+	groupBy  []SettingColumn
+	having   []qb.Predicate
+
+	onConflictUpdate []SettingColumn
+
+	selected  []SettingColumn
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []Setting) error
+
+	limit  int
+	offset int
+
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
+
+	debugMode bool
+}
+
+func Settings() SettingQueryBuilder {
+	return &_dont_use_setting_query_builder{}
+}
+
+func (q *_dont_use_setting_query_builder) SQL() (string, error) {
+	if q.mode == "" {
+		q.mode = "select"
+	}
+	q.args = nil
+
+	var query string
+	var err error
+	if q.mode == "select" {
+		query, err = q.sqlSelect()
+	} else if q.mode == "update" {
+		query, err = q.sqlUpdate()
+	} else if q.mode == "delete" {
+		query, err = q.sqlDelete()
+	} else {
+		return "", fmt.Errorf("unsupported query mode '%s'", q.mode)
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	return query, err
+}
+
+type SettingColumn string
+
+var SettingColumns = struct {
+	Key   SettingColumn
+	Value SettingColumn
+}{
+	Key:   SettingColumn("key"),
+	Value: SettingColumn("value"),
+}
+
+func (q *_dont_use_setting_query_builder) getPlaceholder() string {
+	return "?"
+}
+
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *_dont_use_setting_query_builder) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
+func (q *_dont_use_setting_query_builder) Limit(l int) SettingQueryBuilder {
+	q.mode = "select"
+	q.limit = l
+	return q
+}
+
+func (q *_dont_use_setting_query_builder) Offset(l int) SettingQueryBuilder {
+	q.mode = "select"
+	q.offset = l
+	return q
+}
+
+func (q Setting) Values() []interface{} {
+	var values []interface{}
+	values = append(values, &q.Key)
+	values = append(values, &q.Value)
+
+	return values
+}
+
+func (q *_dont_use_setting_query_builder) Debug() SettingQueryBuilder {
+	q.debugMode = true
+	return q
+}
+
+// SettingsFromRows scans every column of Setting from rows and closes them.
+func SettingsFromRows(rows *sql.Rows) ([]Setting, error) {
+	defer rows.Close()
+	var Settings []Setting
+	for rows.Next() {
+		var m Setting
+		err := rows.Scan(
+
+			&m.Key,
+
+			&m.Value,
+		)
+		if err != nil {
+			return nil, err
+		}
+		Settings = append(Settings, m)
+	}
+	return Settings, rows.Err()
+}
+
+func SettingFromRow(row *sql.Row) (Setting, error) {
+	if row.Err() != nil {
+		return Setting{}, row.Err()
+	}
+	var q Setting
+	err := row.Scan(
+		&q.Key,
+		&q.Value,
+	)
+	if err != nil {
+		return Setting{}, err
+	}
+
+	return q, nil
+}
+
+func (q *_dont_use_setting_query_builder) Update(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_setting_query_builder) Delete(ctx context.Context, db qb.Executor) (sql.Result, error) {
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *_dont_use_setting_query_builder) Fetch(ctx context.Context, db qb.Executor) ([]Setting, error) {
+	return q.fetch(ctx, db)
+}
+
+func (q *_dont_use_setting_query_builder) fetch(ctx context.Context, db qb.Executor) ([]Setting, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := q.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *_dont_use_setting_query_builder) runPreloads(ctx context.Context, db qb.Executor, records []Setting) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *_dont_use_setting_query_builder) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) (Setting, error) {
+	m, err := q.scanRow(row)
+	if err != nil {
+		return Setting{}, err
+	}
+	records := []Setting{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return Setting{}, err
+	}
+	return records[0], nil
+}
+
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *_dont_use_setting_query_builder) Iter(ctx context.Context, db qb.Executor) iter.Seq2[Setting, error] {
+	return func(yield func(Setting, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield(Setting{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m Setting
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield(Setting{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Setting{}, err)
+		}
+	}
+}
+
+// SettingPage is a page of Settings returned by Paginate, Page counts from 1.
+type SettingPage struct {
+	Items    []Setting
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *_dont_use_setting_query_builder) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *_dont_use_setting_query_builder) Paginate(ctx context.Context, db qb.Executor, page, perPage int) (SettingPage, error) {
+	if page < 1 || perPage < 1 {
+		return SettingPage{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return SettingPage{}, err
+	}
+	result := SettingPage{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return SettingPage{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+func (q *_dont_use_setting_query_builder) FindAll(ctx context.Context, db qb.Executor) ([]Setting, error) {
+	return q.Fetch(ctx, db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *_dont_use_setting_query_builder) GroupBy(columns ...SettingColumn) SettingQueryBuilder {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *_dont_use_setting_query_builder) Having(fragment string, args ...any) SettingQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *_dont_use_setting_query_builder) HavingCount(operator qb.Operator, count int64) SettingQueryBuilder {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// SettingGroup is a group of Settings returned by FetchGroups, only the
+// GroupBy fields of the embedded Setting are set.
+type SettingGroup struct {
+	Setting
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *_dont_use_setting_query_builder) FetchGroups(ctx context.Context, db qb.Executor) ([]SettingGroup, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []SettingGroup
+	for rows.Next() {
+		var g SettingGroup
+		if err := rows.Scan(append(q.scanTargets(&g.Setting), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *_dont_use_setting_query_builder) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+// PluckKey returns the key of every matching row.
+func (q *_dont_use_setting_query_builder) PluckKey(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []SettingColumn{SettingColumns.Key}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// PluckValue returns the value of every matching row.
+func (q *_dont_use_setting_query_builder) PluckValue(ctx context.Context, db qb.Executor) ([]string, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []SettingColumn{SettingColumns.Value}
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *string) []any { return []any{v} })
+}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *_dont_use_setting_query_builder) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM settings" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *_dont_use_setting_query_builder) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *_dont_use_setting_query_builder) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM settings" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+func (q *_dont_use_setting_query_builder) First(ctx context.Context, db qb.Executor) (Setting, error) {
+	q.mode = "select"
+
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return Setting{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return Setting{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *_dont_use_setting_query_builder) OrderByAsc(column SettingColumn) SettingQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
+	return q
+}
+
+func (q *_dont_use_setting_query_builder) OrderByDesc(column SettingColumn) SettingQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
+	return q
+}
+
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *_dont_use_setting_query_builder) OrderByRaw(fragment string, args ...any) SettingQueryBuilder {
+	q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of Setting in order, so they have to match them.
+func (q *_dont_use_setting_query_builder) SelectRaw(fragment string, args ...any) SettingQueryBuilder {
+	q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched Settings keep their zero value.
+func (q *_dont_use_setting_query_builder) Select(columns ...SettingColumn) SettingQueryBuilder {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *_dont_use_setting_query_builder) selectedColumns() []SettingColumn {
+	if len(q.selected) > 0 {
+		return q.selected
+	}
+	return []SettingColumn{SettingColumns.Key, SettingColumns.Value}
+}
+
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *_dont_use_setting_query_builder) requireSelected(columns ...SettingColumn) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
+	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *_dont_use_setting_query_builder) target(m *Setting, column SettingColumn) interface{} {
+	switch column {
+	case SettingColumns.Key:
+		return &m.Key
+	case SettingColumns.Value:
+		return &m.Value
+
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *_dont_use_setting_query_builder) scanTargets(m *Setting) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *_dont_use_setting_query_builder) scanRow(row *sql.Row) (Setting, error) {
+	var m Setting
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return Setting{}, err
+	}
+	return m, nil
+}
+
+func (q *_dont_use_setting_query_builder) scanRows(rows *sql.Rows) ([]Setting, error) {
+	var records []Setting
+	for rows.Next() {
+		var m Setting
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *_dont_use_setting_query_builder) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *_dont_use_setting_query_builder) joinColumns(columns []SettingColumn) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&Setting{}, column) == nil {
+			return "", fmt.Errorf("unknown Setting column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *_dont_use_setting_query_builder) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM settings", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
+	}
+
+	if len(q.orderBy) > 0 {
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
+	}
+
+	if q.limit != 0 {
+		base += " LIMIT " + fmt.Sprint(q.limit)
+	}
+	if q.offset != 0 {
+		base += " OFFSET " + fmt.Sprint(q.offset)
+	}
+	return base, nil
+}
+
+func (q *_dont_use_setting_query_builder) sqlUpdate() (string, error) {
+	base := fmt.Sprintf("UPDATE settings ")
+
+	var sets []string
+
+	if q.set.Key.isSet {
+		rhs := q.set.Key.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Key.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "key", rhs))
+	}
+
+	if q.set.Value.isSet {
+		rhs := q.set.Value.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.Value.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "value", rhs))
+	}
+
+	if len(sets) > 0 {
+		base += "SET " + strings.Join(sets, " , ")
+	}
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+func (q *_dont_use_setting_query_builder) sqlDelete() (string, error) {
+	base := fmt.Sprintf("DELETE FROM settings")
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	return base, nil
+}
+
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *_dont_use_setting_query_builder) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *_dont_use_setting_query_builder) WhereRaw(fragment string, args ...any) SettingQueryBuilder {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from settings filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *_dont_use_setting_query_builder) subquery(column SettingColumn) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM settings" + where, nil
+	}
+}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *_dont_use_setting_query_builder) Or(fn func(b SettingQueryBuilder)) SettingQueryBuilder {
+	group := &_dont_use_setting_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *_dont_use_setting_query_builder) And(fn func(b SettingQueryBuilder)) SettingQueryBuilder {
+	group := &_dont_use_setting_query_builder{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
+// WhereKey compares key using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_setting_query_builder) WhereKey(operator qb.Operator, Key string) SettingQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereKeyILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(key) LIKE LOWER(?)", Arguments: []any{Key}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "key", Operator: operator, Argument: Key})
+	return q
+}
+
+func (q *_dont_use_setting_query_builder) WhereKeyIs(Key string) SettingQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "key", Operator: qb.Eq, Argument: Key})
+	return q
+}
+
+// WhereKeyIn matches rows whose key is one of Keys, an empty list matches nothing.
+func (q *_dont_use_setting_query_builder) WhereKeyIn(Keys ...string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "key", Operator: qb.In, Arguments: qb.Args(Keys)})
+	return q
+}
+
+// WhereKeyNotIn matches rows whose key is none of Keys, an empty list matches everything.
+func (q *_dont_use_setting_query_builder) WhereKeyNotIn(Keys ...string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "key", Operator: qb.NotIn, Arguments: qb.Args(Keys)})
+	return q
+}
+
+// WhereKeyLike matches key against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_setting_query_builder) WhereKeyLike(pattern string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "key", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereKeyILike matches key against pattern ignoring case.
+func (q *_dont_use_setting_query_builder) WhereKeyILike(pattern string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(key) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereKeyStartsWith matches rows whose key starts with prefix, prefix is matched literally.
+func (q *_dont_use_setting_query_builder) WhereKeyStartsWith(prefix string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "key LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereKeyContains matches rows whose key contains substring, substring is matched literally.
+func (q *_dont_use_setting_query_builder) WhereKeyContains(substring string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "key LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+// WhereValue compares value using operator, SQL fails for operators not declared by qb.
+func (q *_dont_use_setting_query_builder) WhereValue(operator qb.Operator, Value string) SettingQueryBuilder {
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in WhereValueILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER(value) LIKE LOWER(?)", Arguments: []any{Value}})
+		return q
+	}
+	q.where = append(q.where, qb.Predicate{Column: "value", Operator: operator, Argument: Value})
+	return q
+}
+
+func (q *_dont_use_setting_query_builder) WhereValueIs(Value string) SettingQueryBuilder {
+
+	q.where = append(q.where, qb.Predicate{Column: "value", Operator: qb.Eq, Argument: Value})
+	return q
+}
+
+// WhereValueIn matches rows whose value is one of Values, an empty list matches nothing.
+func (q *_dont_use_setting_query_builder) WhereValueIn(Values ...string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "value", Operator: qb.In, Arguments: qb.Args(Values)})
+	return q
+}
+
+// WhereValueNotIn matches rows whose value is none of Values, an empty list matches everything.
+func (q *_dont_use_setting_query_builder) WhereValueNotIn(Values ...string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "value", Operator: qb.NotIn, Arguments: qb.Args(Values)})
+	return q
+}
+
+// WhereValueLike matches value against pattern, % and _ in pattern are wildcards.
+func (q *_dont_use_setting_query_builder) WhereValueLike(pattern string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Column: "value", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// WhereValueILike matches value against pattern ignoring case.
+func (q *_dont_use_setting_query_builder) WhereValueILike(pattern string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "LOWER(value) LIKE LOWER(?)", Arguments: []any{pattern}})
+	return q
+}
+
+// WhereValueStartsWith matches rows whose value starts with prefix, prefix is matched literally.
+func (q *_dont_use_setting_query_builder) WhereValueStartsWith(prefix string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "value LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// WhereValueContains matches rows whose value contains substring, substring is matched literally.
+func (q *_dont_use_setting_query_builder) WhereValueContains(substring string) SettingQueryBuilder {
+	q.where = append(q.where, qb.Predicate{Raw: "value LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
+	return q
+}
+
+func (q *_dont_use_setting_query_builder) SetKey(Key string) SettingQueryBuilder {
+	q.mode = "update"
+	q.set.Key.argument = Key
+	q.set.Key.literal = ""
+	q.set.Key.isSet = true
+	return q
+}
+
+func (q *_dont_use_setting_query_builder) SetValue(Value string) SettingQueryBuilder {
+	q.mode = "update"
+	q.set.Value.argument = Value
+	q.set.Value.literal = ""
+	q.set.Value.isSet = true
+	return q
+}
+
+func (q *_dont_use_setting_query_builder) Add(ctx context.Context, db qb.Executor, record *Setting) error {
+	query := "INSERT INTO settings (key, value) VALUES (?, ?)"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, record.Key, record.Value)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.
+func (q *_dont_use_setting_query_builder) AddMany(ctx context.Context, db qb.Executor, records []*Setting) error {
+	const chunkSize = 999 / 2
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *_dont_use_setting_query_builder) addMany(ctx context.Context, db qb.Executor, records []*Setting) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "("+strings.Join([]string{q.bind(record.Key), q.bind(record.Value)}, ", ")+")")
+	}
+	query := "INSERT INTO settings (key, value) VALUES " + strings.Join(values, ", ")
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *_dont_use_setting_query_builder) OnConflictUpdate(columns ...SettingColumn) SettingQueryBuilder {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]SettingColumn{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns. conflictColumns defaults to the
+// primary key unless the database fills it in.
+func (q *_dont_use_setting_query_builder) Upsert(ctx context.Context, db qb.Executor, record *Setting, conflictColumns ...SettingColumn) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []SettingColumn{}
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []SettingColumn{SettingColumns.Key, SettingColumns.Value} {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
+	}
+
+	q.args = nil
+	query := "INSERT INTO settings (key, value) VALUES (" + strings.Join([]string{q.bind(record.Key), q.bind(record.Value)}, ", ") + ")"
+
+	var sets []string
+
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		return fmt.Errorf("upsert of Setting needs conflictColumns to update the conflicting row, Setting has no primary key")
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+
+	_, err := db.ExecContext(ctx, query, q.args...)
+	return err
+
+}
+
+	
\ No newline at end of file
@@ -8,8 +8,10 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"text/template"
 
@@ -19,28 +21,127 @@ import (
 
 func main() {
 	var file string
+	var pkg string
 	var dialect string
+	var legacy bool
 	flag.StringVar(&file, "file", "", "path to the file to generate the query builder for")
+	flag.StringVar(&pkg, "package", "", "path to a directory to generate the query builders of all its packages for")
 	flag.StringVar(&dialect, "dialect", "mysql", "dialect to generate the query builder for")
+	flag.BoolVar(&legacy, "legacy", false, "keep the old terminal signatures that don't accept a context.Context")
 	flag.Parse()
 
-	if file == "" {
+	switch {
+	case file != "":
+		generateForFile(dialect, legacy, file)
+	case pkg != "":
+		generate(dialect, legacy, pkg)
+	default:
 		flag.Usage()
-		return
 	}
-
-	generateForFile(dialect, file)
 }
 
 const ModelAnnotation = "@querybuilder"
 
 type structField struct {
-	Name         string
-	ColumnName   string
-	Type         string
-	IsComparable bool
-	IsNullable   bool
-	Tag          string
+	Name            string
+	ColumnName      string
+	Type            string
+	IsComparable    bool
+	IsNullable      bool
+	IsPrimaryKey    bool
+	IsAutoIncrement bool
+	IsReadOnly      bool
+	// BelongsTo is the model the field references, set by `qb:"belongs_to=Model"`.
+	BelongsTo string
+	// IsBelongsTo is set by `qb:"belongs_to"` on a pointer to a model the model
+	// belongs to, the field the related row is preloaded into. It's not a column.
+	IsBelongsTo bool
+	// HasMany is set by `qb:"has_many"` on a slice of a related model, the field
+	// is not a column.
+	HasMany bool
+	// ForeignKey is set by `qb:"has_many=Field"`, the field of the related model
+	// that references the model when it belongs to it through more than one field.
+	ForeignKey string
+	Tag        string
+}
+
+// IsInsertable reports whether the field should be part of the column list of
+// an INSERT statement. Auto increment and readonly columns are filled in by the
+// database.
+func (s structField) IsInsertable() bool {
+	return !s.IsAutoIncrement && !s.IsReadOnly
+}
+
+// NullCheck returns the expression that reports whether the given variable of the
+// field type holds NULL.
+func (s structField) NullCheck(name string) string {
+	if strings.HasPrefix(s.Type, "*") {
+		return name + " == nil"
+	}
+	return "!" + name + ".Valid"
+}
+
+// nullTypes maps the sql.Null types to the type of the value they hold and the
+// field holding it.
+var nullTypes = map[string]struct{ Type, Field string }{
+	"sql.NullString":  {"string", "String"},
+	"sql.NullInt64":   {"int64", "Int64"},
+	"sql.NullInt32":   {"int32", "Int32"},
+	"sql.NullInt16":   {"int16", "Int16"},
+	"sql.NullByte":    {"byte", "Byte"},
+	"sql.NullFloat64": {"float64", "Float64"},
+	"sql.NullBool":    {"bool", "Bool"},
+	"sql.NullTime":    {"time.Time", "Time"},
+}
+
+// BaseType returns the type of the value the field holds when it's not NULL.
+func (s structField) BaseType() string {
+	switch {
+	case !s.IsNullable:
+		return s.Type
+	case strings.HasPrefix(s.Type, "*"):
+		return s.Type[1:]
+	case strings.HasPrefix(s.Type, "sql.Null["):
+		return strings.TrimSuffix(strings.TrimPrefix(s.Type, "sql.Null["), "]")
+	}
+	return nullTypes[s.Type].Type
+}
+
+// Value returns the expression of the value held by the given variable of the
+// field type, the variable must not hold NULL.
+func (s structField) Value(name string) string {
+	switch {
+	case !s.IsNullable:
+		return name
+	case strings.HasPrefix(s.Type, "*"):
+		return "*" + name
+	case strings.HasPrefix(s.Type, "sql.Null["):
+		return name + ".V"
+	}
+	return name + "." + nullTypes[s.Type].Field
+}
+
+// Wrap returns the expression of the field type holding the given variable of
+// its BaseType.
+func (s structField) Wrap(name string) string {
+	switch {
+	case !s.IsNullable:
+		return name
+	case strings.HasPrefix(s.Type, "*"):
+		return "&" + name
+	case strings.HasPrefix(s.Type, "sql.Null["):
+		return s.Type + "{V: " + name + ", Valid: true}"
+	}
+	return s.Type + "{" + nullTypes[s.Type].Field + ": " + name + ", Valid: true}"
+}
+
+// IsString reports whether the column holds text and supports pattern matching.
+func (s structField) IsString() bool {
+	switch s.Type {
+	case "string", "*string", "sql.NullString", "sql.Null[string]":
+		return true
+	}
+	return false
 }
 
 func (s structField) String() string {
@@ -63,43 +164,167 @@ func isComparable(typeExpr ast.Expr) bool {
 	return false
 }
 
-func resolveTypes(structDecl *ast.StructType) []structField {
-	var fields []structField
+func isInteger(typeName string) bool {
+	switch typeName {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
+// annotationOptions parses `key=value` pairs that follow the model annotation,
+// eg: `@querybuilder pk=Code`.
+func annotationOptions(comment string) map[string]string {
+	options := map[string]string{}
+	idx := strings.Index(comment, ModelAnnotation)
+	if idx == -1 {
+		return options
+	}
+	line := strings.SplitN(comment[idx+len(ModelAnnotation):], "\n", 2)[0]
+	for _, option := range strings.Fields(line) {
+		key, value, _ := strings.Cut(option, "=")
+		options[key] = value
+	}
+	return options
+}
+
+// resolvePrimaryKey marks the primary key fields. Fields tagged with `qb:"pk"` win,
+// then fields named in the `pk=` annotation option and at last a field called ID.
+// An integer ID found by the fallback is treated as auto increment.
+func resolvePrimaryKey(fields []structField, options map[string]string) {
+	for _, field := range fields {
+		if field.IsPrimaryKey {
+			return
+		}
+	}
+
+	if pk := options["pk"]; pk != "" {
+		for _, name := range strings.Split(pk, ",") {
+			for i := range fields {
+				if fields[i].Name == strings.TrimSpace(name) {
+					fields[i].IsPrimaryKey = true
+				}
+			}
+		}
+		return
+	}
+
+	for i := range fields {
+		if fields[i].Name == "ID" {
+			fields[i].IsPrimaryKey = true
+			if isInteger(fields[i].Type) {
+				fields[i].IsAutoIncrement = true
+			}
+			return
+		}
+	}
+}
+
+// isNullable reports whether a column of the given type can hold NULL, that is
+// pointer types, sql.Null* types and the generic sql.Null[T].
+func isNullable(typeExpr ast.Expr) bool {
+	switch t := typeExpr.(type) {
+	case *ast.StarExpr:
+		return true
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		return ok && pkg.Name == "sql" && strings.HasPrefix(t.Sel.Name, "Null")
+	case *ast.IndexExpr:
+		return isNullable(t.X)
+	}
+	return false
+}
+
+// applyTag reads `db` and `qb` struct tags of a field.
+// `db:"col_name"` overrides the column name and `db:"-"` skips the field entirely,
+// `qb:"pk,autoincrement,readonly"` sets the column options and
+// `qb:"belongs_to=User"` or `qb:"has_many"` declare relations, `qb:"has_many=UserID"`
+// names the foreign key of the related model.
+// It returns false if the field should not be mapped to a column.
+func applyTag(sf *structField, tag string) bool {
+	structTag := reflect.StructTag(strings.Trim(tag, "`"))
+
+	if column, ok := structTag.Lookup("db"); ok {
+		column = strings.TrimSpace(strings.Split(column, ",")[0])
+		if column == "-" {
+			return false
+		}
+		if column != "" {
+			sf.ColumnName = column
+		}
+	}
+
+	for _, option := range strings.Split(structTag.Get("qb"), ",") {
+		option, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+		switch option {
+		case "belongs_to":
+			sf.BelongsTo = value
+			sf.IsBelongsTo = value == ""
+		case "has_many":
+			sf.HasMany = true
+			sf.ForeignKey = value
+		case "pk":
+			sf.IsPrimaryKey = true
+		case "autoincrement":
+			sf.IsAutoIncrement = true
+		case "readonly":
+			sf.IsReadOnly = true
+		}
+	}
+
+	return true
+}
+
+// resolveTypes returns the columns of a struct and the fields holding its related models.
+func resolveTypes(structDecl *ast.StructType) ([]structField, []structField) {
+	var fields, relationFields []structField
 	for _, field := range structDecl.Fields.List {
 		for _, name := range field.Names {
 			sf := structField{
 				Name:         name.Name,
 				ColumnName:   strcase.ToSnake(name.Name),
-				Type:         fmt.Sprint(field.Type),
+				Type:         types.ExprString(field.Type),
 				IsComparable: isComparable(field.Type),
-				IsNullable:   false, // TODO: fix this
+				IsNullable:   isNullable(field.Type),
 			}
 			if field.Tag != nil {
 				sf.Tag = field.Tag.Value
+				if !applyTag(&sf, sf.Tag) {
+					continue
+				}
+			}
+			if sf.HasMany || sf.IsBelongsTo {
+				relationFields = append(relationFields, sf)
+				continue
 			}
 			fields = append(fields, sf)
 		}
 	}
-	return fields
+	return fields, relationFields
 }
 
-func generateForStruct(dialect string, pkg string, name string, structDecl *ast.StructType) string {
-	fields := resolveTypes(structDecl)
-	var buff bytes.Buffer
+func newTemplateData(dialect string, legacy bool, pkg string, name string, declComment string, structDecl *ast.StructType) *templateData {
+	fields, relationFields := resolveTypes(structDecl)
+	resolvePrimaryKey(fields, annotationOptions(declComment))
 	// if strings.Contains(strings.ToLower(name), "model") {
 	// 	name = strings.Replace(strings.ToLower(name), "model", "", -1)
 	// 	name = strcase.ToCamel(name)
 	// }
-	td := templateData{
+	return &templateData{
 		ModelName:                 name,
 		QueryBuilderStructName:    fmt.Sprintf("_dont_use_%s_query_builder", strings.ToLower(name)),
 		QueryBuilderInterfaceName: name + "QueryBuilder",
 		Fields:                    fields,
+		relationFields:            relationFields,
 		Pkg:                       pkg,
 		Dialect:                   dialect,
+		Legacy:                    legacy,
 		TableName:                 strcase.ToSnake(pluralize.NewClient().Plural(name)),
 	}
+}
 
+func generateForStruct(td *templateData) string {
+	var buff bytes.Buffer
 	err := tmpl.Execute(&buff, td)
 	if err != nil {
 		panic(err)
@@ -113,36 +338,15 @@ func generateForStruct(dialect string, pkg string, name string, structDecl *ast.
 	return string(out)
 }
 
-func generateForFile(dialect string, filePath string) {
-	inputFilePath, err := filepath.Abs(filePath)
-	if err != nil {
-		panic(err)
-	}
-
-	pathList := filepath.SplitList(inputFilePath)
-	pathList = pathList[:len(pathList)-1]
-	fileDir := filepath.Join(pathList...)
+// parseFile returns the package name of the file at filePath and the models it declares.
+func parseFile(dialect string, legacy bool, filePath string) (string, []*templateData) {
 	fileSet := token.NewFileSet()
 	fileAst, err := parser.ParseFile(fileSet, filePath, nil, parser.ParseComments)
 	if err != nil {
 		panic(err)
 	}
 
-	actualName := strings.TrimSuffix(filePath, filepath.Ext(filePath))
-	outputFilePath := filepath.Join(fileDir, fmt.Sprintf("%s_model_gen.go", actualName))
-	outputFile, err := os.Create(outputFilePath)
-	if err != nil {
-		panic(err)
-	}
-	defer func(outputFile *os.File) {
-		err := outputFile.Close()
-		if err != nil {
-			fmt.Println(err)
-		}
-	}(outputFile)
-
-	var notEmpty bool
-
+	var models []*templateData
 	for _, decl := range fileAst.Decls {
 		if _, ok := decl.(*ast.GenDecl); ok {
 
@@ -161,43 +365,118 @@ func generateForFile(dialect string, filePath string) {
 				continue
 			}
 
-			var codes []string
-
 			if strings.Contains(typeSpec.Name.Name, "Model") ||
-				(len(declComment) > 0 && declComment[:len(ModelAnnotation)] == ModelAnnotation) {
-
-				output := generateForStruct(dialect, fileAst.Name.String(), typeSpec.Name.Name, structType)
-				if output == "" {
-					continue
-				}
-				codes = append(codes, output)
-				notEmpty = true
+				strings.HasPrefix(declComment, ModelAnnotation) {
+				models = append(models, newTemplateData(dialect, legacy, fileAst.Name.String(), typeSpec.Name.Name, declComment, structType))
 			}
+		}
+	}
+	return fileAst.Name.String(), models
+}
 
-			if len(codes) > 0 {
-				err = fileTemplate.Execute(outputFile, struct {
-					Pkg  string
-					Code string
-				}{Pkg: fileAst.Name.String(), Code: strings.Join(codes, "\n\n")})
-				if err != nil {
-					panic(err)
-				}
+// packageFile is a source file of a package and the models it declares.
+type packageFile struct {
+	path   string
+	pkg    string
+	models []*templateData
+}
+
+// parsePackage parses the models of every source file in dir and resolves the
+// relations between them, a model can reference the models of any file of its package.
+func parsePackage(dialect string, legacy bool, dir string) []packageFile {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		panic(err)
+	}
+
+	var files []packageFile
+	models := map[string]*templateData{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.Contains(name, "_gen") {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		pkg, fileModels := parseFile(dialect, legacy, path)
+		for _, model := range fileModels {
+			models[model.ModelName] = model
+		}
+		files = append(files, packageFile{path: path, pkg: pkg, models: fileModels})
+	}
+
+	for _, file := range files {
+		for _, model := range file.models {
+			if err := model.resolveRelations(models); err != nil {
+				panic(err)
 			}
+		}
+	}
+	return files
+}
 
+// writeFile writes the query builders of the models of a source file next to it.
+func writeFile(file packageFile) {
+	actualName := strings.TrimSuffix(file.path, filepath.Ext(file.path))
+	outputFilePath := fmt.Sprintf("%s_model_gen.go", actualName)
+
+	var codes []string
+	for _, model := range file.models {
+		if output := generateForStruct(model); output != "" {
+			codes = append(codes, output)
 		}
 	}
-	if !notEmpty {
+	if len(codes) == 0 {
 		os.Remove(outputFilePath)
+		return
+	}
+
+	outputFile, err := os.Create(outputFilePath)
+	if err != nil {
+		panic(err)
+	}
+	defer func(outputFile *os.File) {
+		err := outputFile.Close()
+		if err != nil {
+			fmt.Println(err)
+		}
+	}(outputFile)
+
+	err = fileTemplate.Execute(outputFile, struct {
+		Pkg  string
+		Code string
+	}{Pkg: file.pkg, Code: strings.Join(codes, "\n\n")})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// generateForFile generates the query builders of the models declared in the file
+// at filePath, the other files of its package are parsed for the related models.
+func generateForFile(dialect string, legacy bool, filePath string) {
+	inputFilePath, err := filepath.Abs(filePath)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, file := range parsePackage(dialect, legacy, filepath.Dir(inputFilePath)) {
+		if file.path == inputFilePath {
+			writeFile(file)
+			return
+		}
 	}
+	panic(fmt.Errorf("%s is not a source file of its package", filePath))
 }
 
-func generate(dialect string, packagePath string) {
+// generate generates the query builders of every package under packagePath.
+func generate(dialect string, legacy bool, packagePath string) {
 	err := filepath.Walk(packagePath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-		if !info.IsDir() && strings.HasSuffix(info.Name(), ".go") && !strings.Contains(info.Name(), "_gen") {
-			generateForFile(dialect, path)
+		if info.IsDir() {
+			for _, file := range parsePackage(dialect, legacy, path) {
+				writeFile(file)
+			}
 		}
 		return nil
 	})
@@ -213,12 +492,29 @@ var funcMap = template.FuncMap{
 	"ToLowerCamelCase": func(name string) string {
 		return strcase.ToLowerCamel(name)
 	},
-	"joinPlaceholders": func(l int, placeholder string) string {
-		return strings.Join(strings.Split(strings.Repeat(placeholder, l), ""), ", ")
+	"joinPlaceholders": func(l int, dialect string) string {
+		placeholders := make([]string, l)
+		for i := range placeholders {
+			placeholders[i] = "?"
+			if dialect == "postgres" {
+				placeholders[i] = fmt.Sprintf("$%d", i+1)
+			}
+		}
+		return strings.Join(placeholders, ", ")
 	},
 	"join": func(slice []string) string {
 		return strings.Join(slice, ", ")
 	},
+	// bindLimit is the maximum number of bind parameters a single statement can have.
+	"bindLimit": func(dialect string) int {
+		switch dialect {
+		case "sqlite":
+			// SQLite before 3.32 only allows 999, newer versions allow 32766.
+			return 999
+		default:
+			return 65535
+		}
+	},
 	"joinFields": func(fields []structField) string {
 		var names []string
 		for _, field := range fields {
@@ -236,6 +532,188 @@ type templateData struct {
 	TableName                 string
 	Fields                    []structField
 	Dialect                   string
+	// Legacy keeps the signatures Fetch, FindAll, First, Last, Update, Delete and
+	// Add had before they accepted a context.Context, the newer terminals always do.
+	Legacy bool
+	// Relations are the resolved belongs_to and has_many relations of the model.
+	Relations []relation
+
+	relationFields []structField
+}
+
+// relation is an association of a model with another model of its package. A
+// belongs_to relation is declared with `qb:"belongs_to=User"` on the foreign key,
+// nullable or not, and a has_many one with `qb:"has_many"` on a slice of the
+// related model, the related model must declare the matching belongs_to.
+type relation struct {
+	// Name names the generated Where<Name>Has and <Name>Query methods, it's the
+	// foreign key without its ID suffix for belongs_to and the slice for has_many.
+	Name    string
+	Related *templateData
+	// Field of the model holds the value of RelatedField of the related rows.
+	Field        structField
+	RelatedField structField
+	Many         bool
+	// Target is the field the related rows are preloaded into, nil if there is none.
+	Target *structField
+}
+
+// resolveRelations resolves the relations of the model against the models of its package.
+func (t *templateData) resolveRelations(models map[string]*templateData) error {
+	for _, field := range t.Fields {
+		if field.BelongsTo == "" {
+			continue
+		}
+		related, ok := models[field.BelongsTo]
+		if !ok {
+			return fmt.Errorf("%s.%s belongs to %s which is not a model of the package", t.ModelName, field.Name, field.BelongsTo)
+		}
+		pk := related.PrimaryKey()
+		if pk == nil {
+			return fmt.Errorf("%s.%s belongs to %s which doesn't have a single column primary key", t.ModelName, field.Name, field.BelongsTo)
+		}
+		if pk.Type != field.BaseType() {
+			return fmt.Errorf("%s.%s is a %s but the primary key of %s is a %s", t.ModelName, field.Name, field.Type, related.ModelName, pk.Type)
+		}
+		name := strings.TrimSuffix(field.Name, "ID")
+		if name == "" {
+			name = field.Name
+		}
+		t.Relations = append(t.Relations, relation{Name: name, Related: related, Field: field, RelatedField: *pk})
+	}
+
+	for _, field := range t.relationFields {
+		name := strings.TrimLeft(field.Type, "[]*")
+		if field.IsBelongsTo {
+			if field.Type != "*"+name {
+				return fmt.Errorf("%s.%s must be a *%s to hold the %s it belongs to", t.ModelName, field.Name, name, name)
+			}
+			// the relation named after the field, or else the only one to the model.
+			var belongsTo, only *relation
+			candidates := 0
+			for i := range t.Relations {
+				if t.Relations[i].Many || t.Relations[i].Related.ModelName != name {
+					continue
+				}
+				if t.Relations[i].Name == field.Name {
+					belongsTo = &t.Relations[i]
+				}
+				only = &t.Relations[i]
+				candidates++
+			}
+			if belongsTo == nil && candidates > 1 {
+				return fmt.Errorf("%s.%s is ambiguous, %s belongs to %s through more than one field, name it after one of them", t.ModelName, field.Name, t.ModelName, name)
+			}
+			if belongsTo == nil {
+				belongsTo = only
+			}
+			if belongsTo == nil {
+				return fmt.Errorf("%s.%s holds a %s but no field of %s is tagged belongs_to=%s", t.ModelName, field.Name, name, t.ModelName, name)
+			}
+			if belongsTo.Target != nil {
+				return fmt.Errorf("%s.%s and %s.%s both hold the %s of %s.%s", t.ModelName, belongsTo.Target.Name, t.ModelName, field.Name, name, t.ModelName, belongsTo.Field.Name)
+			}
+			belongsTo.Target = &field
+			continue
+		}
+
+		if field.Type != "[]"+name {
+			return fmt.Errorf("%s.%s must be a []%s to hold the %s it has", t.ModelName, field.Name, name, name)
+		}
+		related, ok := models[name]
+		if !ok {
+			return fmt.Errorf("%s.%s has many %s which is not a model of the package", t.ModelName, field.Name, name)
+		}
+		pk := t.PrimaryKey()
+		if pk == nil {
+			return fmt.Errorf("%s.%s needs %s to have a single column primary key", t.ModelName, field.Name, t.ModelName)
+		}
+		var foreignKey *structField
+		for _, relatedField := range related.Fields {
+			if relatedField.BelongsTo != t.ModelName || (field.ForeignKey != "" && relatedField.Name != field.ForeignKey) {
+				continue
+			}
+			if foreignKey != nil {
+				return fmt.Errorf("%s.%s is ambiguous, %s belongs to %s through more than one field, tag it has_many=<%s field>", t.ModelName, field.Name, name, t.ModelName, name)
+			}
+			foreignKey = &relatedField
+		}
+		if foreignKey == nil && field.ForeignKey != "" {
+			return fmt.Errorf("%s.%s has many %s through %s but %s.%s is not tagged belongs_to=%s", t.ModelName, field.Name, name, field.ForeignKey, name, field.ForeignKey, t.ModelName)
+		}
+		if foreignKey == nil {
+			return fmt.Errorf("%s.%s has many %s but no field of %s is tagged belongs_to=%s", t.ModelName, field.Name, name, name, t.ModelName)
+		}
+		t.Relations = append(t.Relations, relation{Name: field.Name, Related: related, Field: *pk, RelatedField: *foreignKey, Many: true, Target: &field})
+	}
+
+	names := map[string]bool{}
+	for _, relation := range t.Relations {
+		if names[relation.Name] {
+			return fmt.Errorf("%s has more than one relation named %s", t.ModelName, relation.Name)
+		}
+		names[relation.Name] = true
+	}
+	return nil
+}
+
+// PrimaryKeys returns all the fields that build the primary key of the model.
+func (t templateData) PrimaryKeys() []structField {
+	var fields []structField
+	for _, field := range t.Fields {
+		if field.IsPrimaryKey {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// PrimaryKey returns the primary key field of the model or nil if the model has
+// no primary key or a composite one.
+func (t templateData) PrimaryKey() *structField {
+	pks := t.PrimaryKeys()
+	if len(pks) != 1 {
+		return nil
+	}
+	return &pks[0]
+}
+
+// HasCompositeKey reports whether the primary key of the model spans more than one column.
+func (t templateData) HasCompositeKey() bool {
+	return len(t.PrimaryKeys()) > 1
+}
+
+// AutoIncrementField returns the field that is filled by the database on insert or nil.
+func (t templateData) AutoIncrementField() *structField {
+	for _, field := range t.Fields {
+		if field.IsAutoIncrement {
+			return &field
+		}
+	}
+	return nil
+}
+
+// ConflictTarget returns the primary key fields when they're all inserted, the
+// default conflict target of Upsert. A key filled in by the database never conflicts.
+func (t templateData) ConflictTarget() []structField {
+	pks := t.PrimaryKeys()
+	for _, pk := range pks {
+		if !pk.IsInsertable() {
+			return nil
+		}
+	}
+	return pks
+}
+
+// InsertFields returns the fields that are part of INSERT statements.
+func (t templateData) InsertFields() []structField {
+	var fields []structField
+	for _, field := range t.Fields {
+		if field.IsInsertable() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
 }
 
 var fileTemplate = template.Must(template.New("modelgenfile").Funcs(funcMap).Parse(`// Code generated by modelgen. DO NOT EDIT
@@ -247,48 +725,125 @@ import (
     "strings"
     "database/sql"
 	"context"
+	"iter"
+
+	"github.com/amirrezaask/gogenerate/querybuilder/qb"
 )
 
 {{ .Code }}
 	`))
 
 var tmpl = template.Must(template.New("modelgen").Funcs(funcMap).Parse(
-	`
+	`{{ define "ctx" }}{{ if not .Legacy }}ctx context.Context, {{ end }}{{ end }}
+{{ define "ctxDecl" }}{{ if .Legacy }}ctx := context.Background(){{ end }}{{ end }}
 type {{.QueryBuilderInterfaceName}} interface{
 	{{ range .Fields }}
 	Where{{.Name}}Is({{.Type}}) {{$.QueryBuilderInterfaceName}}
-	Where{{.Name}}(operator string, rhs {{.Type}}) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}(operator qb.Operator, rhs {{.Type}}) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}In(...{{.Type}}) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}NotIn(...{{.Type}}) {{$.QueryBuilderInterfaceName}}
+	{{ if .IsString }}
+	Where{{.Name}}Like(pattern string) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}ILike(pattern string) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}StartsWith(prefix string) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}Contains(substring string) {{$.QueryBuilderInterfaceName}}
+	{{ end }}
+	{{ if .IsNullable }}
+	Where{{.Name}}IsNull() {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}IsNotNull() {{$.QueryBuilderInterfaceName}}
+	{{ end }}
 	{{ if .IsComparable  }}
-	// Where{{.Name}}GT({{ .Type }}) {{$.QueryBuilderInterfaceName}}
-	// Where{{.Name}}GE({{ .Type }}) {{$.QueryBuilderInterfaceName}}
-	// Where{{.Name}}LT({{ .Type }}) {{$.QueryBuilderInterfaceName}}
-	// Where{{.Name}}LE({{ .Type }}) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}GT({{ .Type }}) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}GE({{ .Type }}) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}LT({{ .Type }}) {{$.QueryBuilderInterfaceName}}
+	Where{{.Name}}LE({{ .Type }}) {{$.QueryBuilderInterfaceName}}
 	{{ end }}
 	{{ end }}
 
+	WhereRaw(fragment string, args ...any) {{$.QueryBuilderInterfaceName}}
+
+	Or(func(b {{$.QueryBuilderInterfaceName}})) {{$.QueryBuilderInterfaceName}}
+	And(func(b {{$.QueryBuilderInterfaceName}})) {{$.QueryBuilderInterfaceName}}
+	{{ range .Relations }}
+	Where{{ .Name }}Has(func(b {{ .Related.QueryBuilderInterfaceName }})) {{$.QueryBuilderInterfaceName}}
+	{{- if .Target }}
+	Preload{{ .Name }}() {{$.QueryBuilderInterfaceName}}
+	{{- end }}
+	{{- end }}
+
 	OrderByAsc(column {{$.ModelName}}Column) {{$.QueryBuilderInterfaceName}}
 	OrderByDesc(column {{$.ModelName}}Column) {{$.QueryBuilderInterfaceName}}
+	OrderByRaw(fragment string, args ...any) {{$.QueryBuilderInterfaceName}}
+
+	Select(columns ...{{$.ModelName}}Column) {{$.QueryBuilderInterfaceName}}
+	SelectRaw(fragment string, args ...any) {{$.QueryBuilderInterfaceName}}
+
+	GroupBy(columns ...{{$.ModelName}}Column) {{$.QueryBuilderInterfaceName}}
+	Having(fragment string, args ...any) {{$.QueryBuilderInterfaceName}}
+	HavingCount(operator qb.Operator, count int64) {{$.QueryBuilderInterfaceName}}
+
+	{{ if .PrimaryKeys }}
+	After(cursor string) {{$.QueryBuilderInterfaceName}}
+	Before(cursor string) {{$.QueryBuilderInterfaceName}}
+	Page(ctx context.Context, db qb.Executor, size int) ({{ $.ModelName }}CursorPage, error)
+	{{ end }}
+
+	Paginate(ctx context.Context, db qb.Executor, page, perPage int) ({{ $.ModelName }}Page, error)
 
 	Limit(int) {{$.QueryBuilderInterfaceName}}
 	Offset(int) {{$.QueryBuilderInterfaceName}}
 
     getPlaceholder() string
 
-	First(db *sql.DB) ({{ $.ModelName }}, error)
-	Last(db *sql.DB) ({{ $.ModelName }}, error)
+	First({{ template "ctx" $ }}db qb.Executor) ({{ $.ModelName }}, error)
+	{{ if .PrimaryKeys }}Last({{ template "ctx" $ }}db qb.Executor) ({{ $.ModelName }}, error){{ end }}
 
-	{{ range .Fields }}
+	{{ with .PrimaryKey }}
+	FindBy{{.Name}}(ctx context.Context, db qb.Executor, {{.Name}} {{.Type}}) ({{ $.ModelName }}, error)
+	DeleteBy{{.Name}}(ctx context.Context, db qb.Executor, {{.Name}} {{.Type}}) (sql.Result, error)
+	UpdateBy{{.Name}}(ctx context.Context, db qb.Executor, {{.Name}} {{.Type}}) (sql.Result, error)
+	{{ end }}
+
+	{{ if .HasCompositeKey }}
+	FindByKey(ctx context.Context, db qb.Executor, key {{ $.ModelName }}Key) ({{ $.ModelName }}, error)
+	DeleteByKey(ctx context.Context, db qb.Executor, key {{ $.ModelName }}Key) (sql.Result, error)
+	UpdateByKey(ctx context.Context, db qb.Executor, key {{ $.ModelName }}Key) (sql.Result, error)
+	{{ end }}
+
+	{{ range .Fields }}{{ if not .IsReadOnly }}
 	Set{{.Name}}({{.Type}}) {{$.QueryBuilderInterfaceName}}
-	{{end}}
+	{{ if .IsNullable }}Set{{.Name}}Null() {{$.QueryBuilderInterfaceName}}{{ end }}
+	{{ end }}{{end}}
 
-	Add(ctx context.Context, record *{{ $.ModelName }}, db *sql.DB) error
+	{{ if .Legacy }}Add(ctx context.Context, record *{{ $.ModelName }}, db qb.Executor) error{{ else }}Add(ctx context.Context, db qb.Executor, record *{{ $.ModelName }}) error{{ end }}
+	{{ if .InsertFields }}
+	AddMany(ctx context.Context, db qb.Executor, records []*{{ $.ModelName }}) error
 
-	Update(db *sql.DB) (sql.Result, error)
+	OnConflictUpdate(columns ...{{ $.ModelName }}Column) {{ $.QueryBuilderInterfaceName }}
+	Upsert(ctx context.Context, db qb.Executor, record *{{ $.ModelName }}, conflictColumns ...{{ $.ModelName }}Column) error
+	{{ end }}
+
+	Update({{ template "ctx" $ }}db qb.Executor) (sql.Result, error)
 
-	Delete(db *sql.DB) (sql.Result, error)
+	Delete({{ template "ctx" $ }}db qb.Executor) (sql.Result, error)
 
-	Fetch(db *sql.DB) ([]{{ $.ModelName }}, error)
-	FindAll(db *sql.DB) ([]{{ $.ModelName }}, error)
+	Fetch({{ template "ctx" $ }}db qb.Executor) ([]{{ $.ModelName }}, error)
+	FindAll({{ template "ctx" $ }}db qb.Executor) ([]{{ $.ModelName }}, error)
+	Iter(ctx context.Context, db qb.Executor) iter.Seq2[{{ $.ModelName }}, error]
+	{{ if .PrimaryKeys }}Chunk(ctx context.Context, db qb.Executor, size int, fn func([]{{ $.ModelName }}) error) error{{ end }}
+
+	Count(ctx context.Context, db qb.Executor) (int64, error)
+	Exists(ctx context.Context, db qb.Executor) (bool, error)
+	FetchGroups(ctx context.Context, db qb.Executor) ([]{{ $.ModelName }}Group, error)
+	Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error)
+	{{ range .Fields }}Pluck{{.Name}}(ctx context.Context, db qb.Executor) ([]{{.Type}}, error)
+	{{ end }}
+	{{ range .Fields }}{{ if .IsComparable }}
+	Sum{{.Name}}(ctx context.Context, db qb.Executor) ({{.Type}}, error)
+	Min{{.Name}}(ctx context.Context, db qb.Executor) ({{.Type}}, error)
+	Max{{.Name}}(ctx context.Context, db qb.Executor) ({{.Type}}, error)
+	Avg{{.Name}}(ctx context.Context, db qb.Executor) (float64, error)
+	{{ end }}{{ end }}
 
 	SQL() (string, error)
 
@@ -299,32 +854,39 @@ type {{.QueryBuilderInterfaceName}} interface{
 type {{ .QueryBuilderStructName }} struct {
 	mode string
 
-    where struct {
-	{{ range .Fields }}
-		{{.Name}} struct {
-      	  argument interface{}
-          operator string
-    	}
-	{{ end }}
-	}
+	where []qb.Predicate
 
 	set struct {
-	{{ range .Fields }}
-		{{.Name }} string
-    {{ end }}
+	{{ range .Fields }}{{ if not .IsReadOnly }}
+		{{.Name }} struct {
+			argument interface{}
+			literal string
+			isSet bool
+		}
+    {{ end }}{{ end }}
 	}
 
-	orderBy []string
-	groupBy string
+	orderBy []qb.Fragment
+	// sortKeys are the OrderByAsc and OrderByDesc columns, the keyset of Page.
+	sortKeys []qb.SortKey
+	after string
+	before string
+	groupBy []{{ .ModelName }}Column
+	having []qb.Predicate
+
+	onConflictUpdate []{{ .ModelName }}Column
 
-	projected []string
+	selected []{{ .ModelName }}Column
+	projected []qb.Fragment
+
+	// preload loads the related rows of the fetched records, one func per Preload call.
+	preload []func(ctx context.Context, db qb.Executor, records []{{ .ModelName }}) error
 
 	limit int
 	offset int
 
-	whereArgs []interface{}
-    setArgs []interface{}
-	valuesArgs []any
+	// args holds the arguments of the last generated query in placeholder order.
+	args []interface{}
 
 	debugMode bool
 }
@@ -336,6 +898,7 @@ func {{.ModelName}}s() {{ .QueryBuilderInterfaceName }} {
 
 func (q *{{.QueryBuilderStructName}}) SQL() (string, error) {
 	if q.mode == "" { q.mode = "select" }
+	q.args = nil
 
 	var query string
 	var err error
@@ -363,7 +926,7 @@ var {{.ModelName}}Columns = struct {
 	{{ range .Fields }} {{.Name}} {{$.ModelName}}Column
 	{{ end }}
 }{
-	{{ range .Fields }} {{.Name}}: {{$.ModelName}}Column("{{ toSnakeCase .Name }}"),
+	{{ range .Fields }} {{.Name}}: {{$.ModelName}}Column("{{ .ColumnName }}"),
 	{{ end }}
 }
 
@@ -377,10 +940,16 @@ var {{.ModelName}}Columns = struct {
 }
 {{ end }}
 {{ if eq .Dialect "postgres" }}func (q *{{.QueryBuilderStructName}}) getPlaceholder() string {
-	return fmt.Sprintf("$", len(q.whereArgs) + len(q.setArgs) + 1)
+	return fmt.Sprintf("$%d", len(q.args))
 }
 {{ end }}
 
+// bind adds argument to the query arguments and returns its placeholder.
+func (q *{{.QueryBuilderStructName}}) bind(argument interface{}) string {
+	q.args = append(q.args, argument)
+	return q.getPlaceholder()
+}
+
 
 func (q *{{.QueryBuilderStructName}}) Limit(l int) {{ .QueryBuilderInterfaceName }} {
 	q.mode = "select"
@@ -409,7 +978,9 @@ func (q *{{.QueryBuilderStructName}}) Debug() {{ .QueryBuilderInterfaceName }} {
 }
 
 
+// {{ .ModelName }}sFromRows scans every column of {{ .ModelName }} from rows and closes them.
 func {{ .ModelName }}sFromRows(rows *sql.Rows) ([]{{.ModelName}}, error) {
+    defer rows.Close()
     var {{.ModelName}}s []{{.ModelName}}
     for rows.Next() {
         var m {{ .ModelName }}
@@ -423,7 +994,7 @@ func {{ .ModelName }}sFromRows(rows *sql.Rows) ([]{{.ModelName}}, error) {
         }
         {{.ModelName}}s = append({{.ModelName}}s, m)
     }
-    return {{.ModelName}}s, nil
+    return {{.ModelName}}s, rows.Err()
 }
 
 func {{ .ModelName }}FromRow(row *sql.Row) ({{.ModelName}}, error) {
@@ -442,103 +1013,801 @@ func {{ .ModelName }}FromRow(row *sql.Row) ({{.ModelName}}, error) {
     return q, nil
 }
 
-func (q *{{.QueryBuilderStructName}}) Update(db *sql.DB) (sql.Result, error) {
+func (q *{{.QueryBuilderStructName}}) Update({{ template "ctx" . }}db qb.Executor) (sql.Result, error) {
+	{{- template "ctxDecl" . }}
 	q.mode = "update"
-	args := append(q.setArgs, q.whereArgs...)
 	query, err := q.SQL()
 	if err != nil {
 		return nil, err
 	}
-	return db.Exec(query, args...)
+	return db.ExecContext(ctx, query, q.args...)
 }
 
-func (q *{{.QueryBuilderStructName}}) Delete(db *sql.DB) (sql.Result, error) {
+func (q *{{.QueryBuilderStructName}}) Delete({{ template "ctx" . }}db qb.Executor) (sql.Result, error) {
+	{{- template "ctxDecl" . }}
 	q.mode = "delete"
 	query, err := q.SQL()
 	if err != nil {
 		return nil, err
 	}
-	return db.Exec(query, q.whereArgs...)
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *{{.QueryBuilderStructName}}) Fetch({{ template "ctx" . }}db qb.Executor) ([]{{ .ModelName }}, error) {
+	{{- template "ctxDecl" . }}
+	return q.fetch(ctx, db)
 }
 
-func (q *{{.QueryBuilderStructName}}) Fetch(db *sql.DB) ([]{{ .ModelName }}, error) {
+func (q *{{.QueryBuilderStructName}}) fetch(ctx context.Context, db qb.Executor) ([]{{ .ModelName }}, error) {
 	q.mode = "select"
 	query, err := q.SQL()
 	if err != nil {
 		return nil, err
 	}
-	rows, err := db.Query(query, q.whereArgs...)
+	rows, err := db.QueryContext(ctx, query, q.args...)
 	if err != nil {
 		return nil, err
 	}
-	return {{ .ModelName }}sFromRows(rows)
-}
-
-func (q *{{.QueryBuilderStructName}}) FindAll(db *sql.DB) ([]{{ .ModelName }}, error) {
-	return q.Fetch(db)
-}
-
-func (q *{{.QueryBuilderStructName}}) First(db *sql.DB) ({{ .ModelName }}, error) {
-	q.mode = "select"
-	q.orderBy = []string{"ORDER BY id ASC"}
-	q.Limit(1)
-	query, err := q.SQL()
+	defer rows.Close()
+	records, err := q.scanRows(rows)
 	if err != nil {
-		return {{ .ModelName }}{}, err
+		return nil, err
 	}
-	row := db.QueryRow(query, q.whereArgs...)
-	if row.Err() != nil {
-		return {{ .ModelName }}{}, row.Err()
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return nil, err
 	}
-	return {{ .ModelName}}FromRow(row)
+	return records, nil
 }
 
+// runPreloads loads the relations requested with the Preload methods into records.
+func (q *{{.QueryBuilderStructName}}) runPreloads(ctx context.Context, db qb.Executor, records []{{ .ModelName }}) error {
+	for _, preload := range q.preload {
+		if err := preload(ctx, db, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-func (q *{{.QueryBuilderStructName}}) Last(db *sql.DB) ({{ .ModelName }}, error) {
-	q.mode = "select"
-	q.orderBy = []string{"ORDER BY id DESC"}
-	q.Limit(1)
-	query, err := q.SQL()
+// scanOne scans the row of First, Last and FindBy and loads its preloaded relations.
+func (q *{{.QueryBuilderStructName}}) scanOne(ctx context.Context, db qb.Executor, row *sql.Row) ({{ .ModelName }}, error) {
+	m, err := q.scanRow(row)
 	if err != nil {
 		return {{ .ModelName }}{}, err
 	}
-	row := db.QueryRow(query, q.whereArgs...)
-	if row.Err() != nil {
-		return {{ .ModelName}}{}, row.Err()
+	records := []{{ .ModelName }}{m}
+	if err := q.runPreloads(ctx, db, records); err != nil {
+		return {{ .ModelName }}{}, err
 	}
-	return {{ .ModelName }}FromRow(row)
+	return records[0], nil
 }
 
-func (q *{{ $.QueryBuilderStructName }}) OrderByAsc(column {{.ModelName}}Column) {{ $.QueryBuilderInterfaceName }} {
-    q.mode = "select"
-	q.orderBy = append(q.orderBy, fmt.Sprintf("%s ASC", string(column)))
+// Iter streams the matching rows without holding them in memory, the rows are
+// closed when the loop ends, even on break. A failed query, scan or iteration is
+// yielded as the last element. The Preload methods don't apply to Iter, use Fetch
+// or Chunk to load relations.
+func (q *{{.QueryBuilderStructName}}) Iter(ctx context.Context, db qb.Executor) iter.Seq2[{{ .ModelName }}, error] {
+	return func(yield func({{ .ModelName }}, error) bool) {
+		rows, err := q.Rows(ctx, db)
+		if err != nil {
+			yield({{ .ModelName }}{}, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m {{ .ModelName }}
+			if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+				yield({{ .ModelName }}{}, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield({{ .ModelName }}{}, err)
+		}
+	}
+}
+
+{{ if .PrimaryKeys }}
+// Chunk calls fn with the matching rows in batches of size in primary key order
+// and stops at the first error. Every batch starts after the key of the last row
+// of the previous one instead of at a growing offset, so the selected columns
+// must include the primary key and Offset is ignored.
+func (q *{{.QueryBuilderStructName}}) Chunk(ctx context.Context, db qb.Executor, size int, fn func([]{{ .ModelName }}) error) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	if err := q.requireSelected({{ range .PrimaryKeys }}{{ $.ModelName }}Columns.{{ .Name }}, {{ end }}); err != nil {
+		return fmt.Errorf("chunk needs the primary key of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to the chunks.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+	where = where[:len(where):len(where)]
+
+	q.orderBy = []qb.Fragment{ {{ range .PrimaryKeys }}{SQL: "{{ .ColumnName }} ASC"},{{ end }} }
+	q.limit = size
+	q.offset = 0
+	for {
+		records, err := q.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(records) < size {
+			return nil
+		}
+		last := records[len(records)-1]
+		{{ if .HasCompositeKey -}}
+		q.where = append(where, qb.Predicate{Raw: "({{ joinFields .PrimaryKeys }}) > ({{ range $i, $f := .PrimaryKeys }}{{ if $i }}, {{ end }}?{{ end }})", Arguments: []any{ {{ range .PrimaryKeys }}last.{{ .Name }}, {{ end }} }})
+		{{- else }}{{ with .PrimaryKey -}}
+		q.where = append(where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.Gt, Argument: last.{{ .Name }}})
+		{{- end }}{{ end }}
+	}
+}
+{{ end }}
+
+// {{ .ModelName }}Page is a page of {{ .ModelName }}s returned by Paginate, Page counts from 1.
+type {{ .ModelName }}Page struct {
+	Items    []{{ .ModelName }}
+	Total    int64
+	Page     int
+	PerPage  int
+	LastPage int
+}
+
+// count returns the number of rows the select query returns regardless of its
+// ordering and limits, a grouped query is counted as a subquery so that every
+// group counts once.
+func (q *{{.QueryBuilderStructName}}) count(ctx context.Context, db qb.Executor) (int64, error) {
+	var total int64
+	if len(q.groupBy) == 0 && len(q.having) == 0 {
+		return total, q.aggregate(ctx, db, "COUNT(*)", &total)
+	}
+
+	orderBy, limit, offset := q.orderBy, q.limit, q.offset
+	q.orderBy, q.limit, q.offset = nil, 0, 0
+	q.args = nil
+	subquery, err := q.sqlSelect()
+	q.orderBy, q.limit, q.offset = orderBy, limit, offset
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM (" + subquery + ") AS grouped"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&total)
+	return total, err
+}
+
+// Paginate returns the page-th page of perPage rows along with the number of
+// matching rows, both queries share the where-clauses of q. With GroupBy or
+// Having the groups are counted rather than the rows.
+func (q *{{.QueryBuilderStructName}}) Paginate(ctx context.Context, db qb.Executor, page, perPage int) ({{ .ModelName }}Page, error) {
+	if page < 1 || perPage < 1 {
+		return {{ .ModelName }}Page{}, fmt.Errorf("invalid page %d of %d rows, both count from 1", page, perPage)
+	}
+	total, err := q.count(ctx, db)
+	if err != nil {
+		return {{ .ModelName }}Page{}, err
+	}
+	result := {{ .ModelName }}Page{
+		Total:    total,
+		Page:     page,
+		PerPage:  perPage,
+		LastPage: max(1, int((total+int64(perPage)-1)/int64(perPage))),
+	}
+	offset := (page - 1) * perPage
+	if int64(offset) >= total {
+		return result, nil
+	}
+	q.limit = perPage
+	q.offset = offset
+	items, err := q.fetch(ctx, db)
+	if err != nil {
+		return {{ .ModelName }}Page{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+{{ if .PrimaryKeys }}
+// {{ .ModelName }}CursorPage is a page of {{ .ModelName }}s returned by Page, Next and Prev
+// are the cursors of the pages after and before it, empty if there are none.
+type {{ .ModelName }}CursorPage struct {
+	Items []{{ .ModelName }}
+	Next  string
+	Prev  string
+}
+
+// After makes Page return the rows after cursor, the Next of a previous page.
+func (q *{{.QueryBuilderStructName}}) After(cursor string) {{ .QueryBuilderInterfaceName }} {
+	q.after = cursor
+	return q
+}
+
+// Before makes Page return the rows before cursor, the Prev of a previous page.
+func (q *{{.QueryBuilderStructName}}) Before(cursor string) {{ .QueryBuilderInterfaceName }} {
+	q.before = cursor
+	return q
+}
+
+// keyset returns the OrderByAsc and OrderByDesc columns followed by the primary
+// key, which breaks the ties between them.
+func (q *{{.QueryBuilderStructName}}) keyset() []qb.SortKey {
+	keys := q.sortKeys[:len(q.sortKeys):len(q.sortKeys)]
+	for _, column := range []{{ .ModelName }}Column{ {{ range .PrimaryKeys }}{{ $.ModelName }}Columns.{{ .Name }}, {{ end }} } {
+		sorted := false
+		for _, key := range keys {
+			sorted = sorted || key.Column == string(column)
+		}
+		if !sorted {
+			keys = append(keys, qb.SortKey{Column: string(column)})
+		}
+	}
+	return keys
+}
+
+func (q *{{.QueryBuilderStructName}}) encodeCursor(record {{ .ModelName }}, keys []qb.SortKey) (string, error) {
+	values := make(map[string]any, len(keys))
+	for _, key := range keys {
+		values[key.Column] = q.target(&record, {{ .ModelName }}Column(key.Column))
+	}
+	return qb.EncodeCursor(values)
+}
+
+func (q *{{.QueryBuilderStructName}}) decodeCursor(cursor string, keys []qb.SortKey) ([]any, error) {
+	var record {{ .ModelName }}
+	targets := make(map[string]any, len(keys))
+	for _, key := range keys {
+		target := q.target(&record, {{ .ModelName }}Column(key.Column))
+		if target == nil {
+			return nil, fmt.Errorf("unknown {{ .ModelName }} column '%s'", key.Column)
+		}
+		targets[key.Column] = target
+	}
+	if err := qb.DecodeCursor(cursor, targets); err != nil {
+		return nil, err
+	}
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = targets[key.Column]
+	}
+	return values, nil
+}
+
+// Page returns up to size rows in the order of the OrderByAsc and OrderByDesc
+// columns and the primary key, starting after or before the cursor set by After
+// or Before. The ordering must not be changed between pages and OrderByRaw can't
+// be part of it, the sort columns must not be NULL and the selected columns must
+// include them. Offset is ignored.
+func (q *{{.QueryBuilderStructName}}) Page(ctx context.Context, db qb.Executor, size int) ({{ .ModelName }}CursorPage, error) {
+	if size <= 0 {
+		return {{ .ModelName }}CursorPage{}, fmt.Errorf("page size must be positive, got %d", size)
+	}
+	if q.after != "" && q.before != "" {
+		return {{ .ModelName }}CursorPage{}, fmt.Errorf("a page can't be both after and before a cursor")
+	}
+	// every ORDER BY fragment but the OrderByRaw ones has a sort key.
+	if len(q.orderBy) != len(q.sortKeys) {
+		return {{ .ModelName }}CursorPage{}, fmt.Errorf("a page can only be ordered by OrderByAsc and OrderByDesc columns")
+	}
+	keys := q.keyset()
+	columns := make([]{{ .ModelName }}Column, len(keys))
+	for i, key := range keys {
+		columns[i] = {{ .ModelName }}Column(key.Column)
+	}
+	if err := q.requireSelected(columns...); err != nil {
+		return {{ .ModelName }}CursorPage{}, fmt.Errorf("a page needs the sort columns of every row: %w", err)
+	}
+	// the keyset, ordering and limit only apply to this page.
+	where, orderBy, limit, offset := q.where, q.orderBy, q.limit, q.offset
+	defer func() {
+		q.where, q.orderBy, q.limit, q.offset = where, orderBy, limit, offset
+	}()
+
+	backward := q.before != ""
+	cursor := q.after
+	if backward {
+		cursor = q.before
+	}
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor, keys)
+		if err != nil {
+			return {{ .ModelName }}CursorPage{}, err
+		}
+		q.where = append(where[:len(where):len(where)], qb.Keyset(keys, values, backward))
+	}
+	// a page before the cursor is read in reverse and flipped back.
+	q.orderBy = nil
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Desc != backward {
+			direction = " DESC"
+		}
+		q.orderBy = append(q.orderBy, qb.Fragment{SQL: key.Column + direction})
+	}
+	q.limit = size + 1
+	q.offset = 0
+	records, err := q.fetch(ctx, db)
+	if err != nil {
+		return {{ .ModelName }}CursorPage{}, err
+	}
+	more := len(records) > size
+	if more {
+		records = records[:size]
+	}
+	if backward {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page := {{ .ModelName }}CursorPage{Items: records}
+	if len(records) == 0 {
+		return page, nil
+	}
+	if more || backward {
+		if page.Next, err = q.encodeCursor(records[len(records)-1], keys); err != nil {
+			return {{ .ModelName }}CursorPage{}, err
+		}
+	}
+	if (more && backward) || (!backward && cursor != "") {
+		if page.Prev, err = q.encodeCursor(records[0], keys); err != nil {
+			return {{ .ModelName }}CursorPage{}, err
+		}
+	}
+	return page, nil
+}
+{{ end }}
+
+func (q *{{.QueryBuilderStructName}}) FindAll({{ template "ctx" . }}db qb.Executor) ([]{{ .ModelName }}, error) {
+	return q.Fetch({{ if not .Legacy }}ctx, {{ end }}db)
+}
+
+// GroupBy groups the selected rows by columns, use FetchGroups to get the row count
+// of every group or Select, SelectRaw and Rows for other aggregates.
+func (q *{{.QueryBuilderStructName}}) GroupBy(columns ...{{ .ModelName }}Column) {{ .QueryBuilderInterfaceName }} {
+	q.mode = "select"
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds fragment as a condition on the groups, ? in fragment are bound to args.
+func (q *{{.QueryBuilderStructName}}) Having(fragment string, args ...any) {{ .QueryBuilderInterfaceName }} {
+	q.having = append(q.having, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// HavingCount keeps the groups whose row count compares to count with operator.
+func (q *{{.QueryBuilderStructName}}) HavingCount(operator qb.Operator, count int64) {{ .QueryBuilderInterfaceName }} {
+	q.having = append(q.having, qb.Predicate{Column: "COUNT(*)", Operator: operator, Argument: count})
+	return q
+}
+
+// {{ .ModelName }}Group is a group of {{ .ModelName }}s returned by FetchGroups, only the
+// GroupBy fields of the embedded {{ .ModelName }} are set.
+type {{ .ModelName }}Group struct {
+	{{ .ModelName }}
+	Count int64
+}
+
+// FetchGroups returns the GroupBy columns and the row count of every group.
+func (q *{{.QueryBuilderStructName}}) FetchGroups(ctx context.Context, db qb.Executor) ([]{{ .ModelName }}Group, error) {
+	if len(q.groupBy) == 0 {
+		return nil, fmt.Errorf("FetchGroups needs GroupBy columns")
+	}
+	// the projection only applies to the groups.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = q.groupBy
+	q.projected = nil
+	for _, column := range q.groupBy {
+		q.projected = append(q.projected, qb.Fragment{SQL: string(column)})
+	}
+	q.projected = append(q.projected, qb.Fragment{SQL: "COUNT(*)"})
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groups []{{ .ModelName }}Group
+	for rows.Next() {
+		var g {{ .ModelName }}Group
+		if err := rows.Scan(append(q.scanTargets(&g.{{ .ModelName }}), &g.Count)...); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, rows.Err()
+}
+
+// Rows runs the select query and returns the rows as is, it's meant for the
+// shapes Fetch can't scan, eg: aggregates of a GroupBy scanned with qb.Collect.
+// The caller must close the rows.
+func (q *{{.QueryBuilderStructName}}) Rows(ctx context.Context, db qb.Executor) (*sql.Rows, error) {
+	q.mode = "select"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, q.args...)
+}
+
+{{ range .Fields }}
+// Pluck{{.Name}} returns the {{ .ColumnName }} of every matching row.
+func (q *{{$.QueryBuilderStructName}}) Pluck{{.Name}}(ctx context.Context, db qb.Executor) ([]{{.Type}}, error) {
+	// the projection only applies to the plucked column.
+	selected, projected := q.selected, q.projected
+	defer func() {
+		q.selected, q.projected = selected, projected
+	}()
+	q.selected = []{{ $.ModelName }}Column{ {{ $.ModelName }}Columns.{{ .Name }} }
+	q.projected = nil
+	rows, err := q.Rows(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return qb.Collect(rows, func(v *{{.Type}}) []any { return []any{v} })
+}
+{{ end }}
+
+// aggregate scans the single value expression evaluates to over the rows
+// matching the where-clauses into dest.
+func (q *{{.QueryBuilderStructName}}) aggregate(ctx context.Context, db qb.Executor, expression string, dest interface{}) error {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return err
+	}
+	query := "SELECT " + expression + " FROM {{ .TableName }}" + where
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	return db.QueryRowContext(ctx, query, q.args...).Scan(dest)
+}
+
+func (q *{{.QueryBuilderStructName}}) Count(ctx context.Context, db qb.Executor) (int64, error) {
+	var count int64
+	err := q.aggregate(ctx, db, "COUNT(*)", &count)
+	return count, err
+}
+
+func (q *{{.QueryBuilderStructName}}) Exists(ctx context.Context, db qb.Executor) (bool, error) {
+	q.args = nil
+	where, err := q.sqlWhere()
+	if err != nil {
+		return false, err
+	}
+	query := "SELECT EXISTS (SELECT 1 FROM {{ .TableName }}" + where + ")"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, query, q.args...).Scan(&exists)
+	return exists, err
+}
+
+{{ range .Fields }}{{ if .IsComparable }}
+// Sum{{.Name}} returns the sum of {{ .ColumnName }} over the matching rows, 0 if there are none.
+func (q *{{$.QueryBuilderStructName}}) Sum{{.Name}}(ctx context.Context, db qb.Executor) ({{.Type}}, error) {
+	var sum sql.Null[{{.Type}}]
+	err := q.aggregate(ctx, db, "SUM({{ .ColumnName }})", &sum)
+	return sum.V, err
+}
+
+// Min{{.Name}} returns the smallest {{ .ColumnName }} of the matching rows, 0 if there are none.
+func (q *{{$.QueryBuilderStructName}}) Min{{.Name}}(ctx context.Context, db qb.Executor) ({{.Type}}, error) {
+	var min sql.Null[{{.Type}}]
+	err := q.aggregate(ctx, db, "MIN({{ .ColumnName }})", &min)
+	return min.V, err
+}
+
+// Max{{.Name}} returns the largest {{ .ColumnName }} of the matching rows, 0 if there are none.
+func (q *{{$.QueryBuilderStructName}}) Max{{.Name}}(ctx context.Context, db qb.Executor) ({{.Type}}, error) {
+	var max sql.Null[{{.Type}}]
+	err := q.aggregate(ctx, db, "MAX({{ .ColumnName }})", &max)
+	return max.V, err
+}
+
+// Avg{{.Name}} returns the average of {{ .ColumnName }} over the matching rows, 0 if there are none.
+func (q *{{$.QueryBuilderStructName}}) Avg{{.Name}}(ctx context.Context, db qb.Executor) (float64, error) {
+	var avg sql.NullFloat64
+	err := q.aggregate(ctx, db, "AVG({{ .ColumnName }})", &avg)
+	return avg.Float64, err
+}
+{{ end }}{{ end }}
+
+func (q *{{.QueryBuilderStructName}}) First({{ template "ctx" . }}db qb.Executor) ({{ .ModelName }}, error) {
+	{{- template "ctxDecl" . }}
+	q.mode = "select"
+	{{ if .PrimaryKeys }}q.orderBy = []qb.Fragment{ {{ range .PrimaryKeys }}{SQL: "{{ .ColumnName }} ASC"},{{ end }} }{{ end }}
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return {{ .ModelName }}{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return {{ .ModelName }}{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+{{ if .PrimaryKeys }}
+func (q *{{.QueryBuilderStructName}}) Last({{ template "ctx" . }}db qb.Executor) ({{ .ModelName }}, error) {
+	{{- template "ctxDecl" . }}
+	q.mode = "select"
+	q.orderBy = []qb.Fragment{ {{ range .PrimaryKeys }}{SQL: "{{ .ColumnName }} DESC"},{{ end }} }
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return {{ .ModelName }}{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return {{ .ModelName}}{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+{{ end }}
+
+{{ with .PrimaryKey }}
+func (q *{{$.QueryBuilderStructName}}) FindBy{{.Name}}(ctx context.Context, db qb.Executor, {{.Name}} {{.Type}}) ({{ $.ModelName }}, error) {
+	q.Where{{.Name}}Is({{.Name}})
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return {{ $.ModelName }}{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return {{ $.ModelName}}{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *{{$.QueryBuilderStructName}}) DeleteBy{{.Name}}(ctx context.Context, db qb.Executor, {{.Name}} {{.Type}}) (sql.Result, error) {
+	q.Where{{.Name}}Is({{.Name}})
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *{{$.QueryBuilderStructName}}) UpdateBy{{.Name}}(ctx context.Context, db qb.Executor, {{.Name}} {{.Type}}) (sql.Result, error) {
+	q.Where{{.Name}}Is({{.Name}})
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+{{ end }}
+
+{{ if .HasCompositeKey }}
+// {{ .ModelName }}Key holds the columns of the composite primary key of {{ .ModelName }}.
+type {{ .ModelName }}Key struct {
+	{{ range .PrimaryKeys }}{{ .Name }} {{ .Type }}
+	{{ end }}
+}
+
+func (q *{{.QueryBuilderStructName}}) whereKey(key {{ .ModelName }}Key) {
+	{{ range .PrimaryKeys }}q.Where{{ .Name }}Is(key.{{ .Name }})
+	{{ end }}
+}
+
+func (q *{{.QueryBuilderStructName}}) FindByKey(ctx context.Context, db qb.Executor, key {{ .ModelName }}Key) ({{ .ModelName }}, error) {
+	q.whereKey(key)
+	q.mode = "select"
+	q.Limit(1)
+	query, err := q.SQL()
+	if err != nil {
+		return {{ .ModelName }}{}, err
+	}
+	row := db.QueryRowContext(ctx, query, q.args...)
+	if row.Err() != nil {
+		return {{ .ModelName}}{}, row.Err()
+	}
+	return q.scanOne(ctx, db, row)
+}
+
+func (q *{{.QueryBuilderStructName}}) DeleteByKey(ctx context.Context, db qb.Executor, key {{ .ModelName }}Key) (sql.Result, error) {
+	q.whereKey(key)
+	q.mode = "delete"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+
+func (q *{{.QueryBuilderStructName}}) UpdateByKey(ctx context.Context, db qb.Executor, key {{ .ModelName }}Key) (sql.Result, error) {
+	q.whereKey(key)
+	q.mode = "update"
+	query, err := q.SQL()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, q.args...)
+}
+{{ end }}
+
+func (q *{{ $.QueryBuilderStructName }}) OrderByAsc(column {{.ModelName}}Column) {{ $.QueryBuilderInterfaceName }} {
+    q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " ASC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column)})
 	return q
 }
 
 func (q *{{ $.QueryBuilderStructName }}) OrderByDesc(column {{.ModelName}}Column) {{ $.QueryBuilderInterfaceName }} {
     q.mode = "select"
-	q.orderBy = append(q.orderBy, fmt.Sprintf("%s DESC", string(column)))
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: string(column) + " DESC"})
+	q.sortKeys = append(q.sortKeys, qb.SortKey{Column: string(column), Desc: true})
 	return q
 }
 
-func (q *{{ .QueryBuilderStructName }}) sqlSelect() (string, error) {
-	if q.projected == nil {
-		q.projected = append(q.projected, "*")
+// OrderByRaw adds fragment to the ORDER BY clause, ? in fragment are bound to args.
+func (q *{{ $.QueryBuilderStructName }}) OrderByRaw(fragment string, args ...any) {{ $.QueryBuilderInterfaceName }} {
+    q.mode = "select"
+	q.orderBy = append(q.orderBy, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// SelectRaw adds fragment to the selected expressions in place of the column list,
+// ? in fragment are bound to args. The expressions are scanned into the columns
+// set by Select, or every column of {{ .ModelName }} in order, so they have to match them.
+func (q *{{ $.QueryBuilderStructName }}) SelectRaw(fragment string, args ...any) {{ $.QueryBuilderInterfaceName }} {
+    q.mode = "select"
+	q.projected = append(q.projected, qb.Fragment{SQL: fragment, Args: args})
+	return q
+}
+
+// Select limits the columns that are queried and scanned, the other fields of
+// the fetched {{ .ModelName }}s keep their zero value.
+func (q *{{ .QueryBuilderStructName }}) Select(columns ...{{ .ModelName }}Column) {{ .QueryBuilderInterfaceName }} {
+	q.mode = "select"
+	q.selected = append(q.selected, columns...)
+	return q
+}
+
+func (q *{{ .QueryBuilderStructName }}) selectedColumns() []{{ .ModelName }}Column {
+	if len(q.selected) > 0 {
+		return q.selected
 	}
-	base := fmt.Sprintf("SELECT %s FROM {{ .TableName }}", strings.Join(q.projected, ", "))
+	return []{{ .ModelName }}Column{ {{ range .Fields }}{{ $.ModelName }}Columns.{{ .Name }}, {{ end }} }
+}
 
-	var wheres []string
-	{{ range .Fields }}
-	if q.where.{{.Name}}.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "{{ toSnakeCase .Name }}", q.where.{{ .Name }}.operator, fmt.Sprint(q.where.{{ .Name }}.argument)))
+// requireSelected returns an error unless the fetched rows hold every one of
+// columns, which can't be told once SelectRaw replaced the projection.
+func (q *{{ .QueryBuilderStructName }}) requireSelected(columns ...{{ .ModelName }}Column) error {
+	if len(q.projected) > 0 {
+		return fmt.Errorf("the columns of SelectRaw are unknown")
+	}
+	selected := q.selectedColumns()
+	for _, column := range columns {
+		found := false
+		for _, s := range selected {
+			found = found || s == column
+		}
+		if !found {
+			return fmt.Errorf("column '%s' is not selected", column)
+		}
 	}
+	return nil
+}
+
+// target returns the field of m column is scanned into, nil for an unknown column.
+func (q *{{ .QueryBuilderStructName }}) target(m *{{ .ModelName }}, column {{ .ModelName }}Column) interface{} {
+	switch column {
+	{{ range .Fields }}case {{ $.ModelName }}Columns.{{ .Name }}:
+		return &m.{{ .Name }}
 	{{ end }}
-	if len(wheres) > 0 {
-		base += " WHERE " + strings.Join(wheres, " AND ")
+	}
+	return nil
+}
+
+// scanTargets returns the fields of m the selected columns are scanned into.
+func (q *{{ .QueryBuilderStructName }}) scanTargets(m *{{ .ModelName }}) []interface{} {
+	columns := q.selectedColumns()
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		targets[i] = q.target(m, column)
+	}
+	return targets
+}
+
+func (q *{{ .QueryBuilderStructName }}) scanRow(row *sql.Row) ({{ .ModelName }}, error) {
+	var m {{ .ModelName }}
+	if err := row.Scan(q.scanTargets(&m)...); err != nil {
+		return {{ .ModelName }}{}, err
+	}
+	return m, nil
+}
+
+func (q *{{ .QueryBuilderStructName }}) scanRows(rows *sql.Rows) ([]{{ .ModelName }}, error) {
+	var records []{{ .ModelName }}
+	for rows.Next() {
+		var m {{ .ModelName }}
+		if err := rows.Scan(q.scanTargets(&m)...); err != nil {
+			return nil, err
+		}
+		records = append(records, m)
+	}
+	return records, rows.Err()
+}
+
+// sqlColumns renders the selected expressions, the selected columns unless
+// SelectRaw replaced them.
+func (q *{{ .QueryBuilderStructName }}) sqlColumns() (string, error) {
+	if len(q.projected) > 0 {
+		return qb.RenderFragments(q.projected, q.bind)
+	}
+	return q.joinColumns(q.selectedColumns())
+}
+
+func (q *{{ .QueryBuilderStructName }}) joinColumns(columns []{{ .ModelName }}Column) (string, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if q.target(&{{ .ModelName }}{}, column) == nil {
+			return "", fmt.Errorf("unknown {{ .ModelName }} column '%s'", column)
+		}
+		names[i] = string(column)
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (q *{{ .QueryBuilderStructName }}) sqlSelect() (string, error) {
+	projected, err := q.sqlColumns()
+	if err != nil {
+		return "", err
+	}
+	base := fmt.Sprintf("SELECT %s FROM {{ .TableName }}", projected)
+
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
+	}
+	base += where
+
+	if len(q.groupBy) > 0 {
+		groupBy, err := q.joinColumns(q.groupBy)
+		if err != nil {
+			return "", err
+		}
+		base += " GROUP BY " + groupBy
+	}
+
+	if len(q.having) > 0 {
+		having, err := qb.Render(q.having, "AND", q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " HAVING " + having
 	}
 
 	if len(q.orderBy) > 0 {
-		base += fmt.Sprintf(" ORDER BY %s", strings.Join(q.orderBy, ", "))
+		orderBy, err := qb.RenderFragments(q.orderBy, q.bind)
+		if err != nil {
+			return "", err
+		}
+		base += " ORDER BY " + orderBy
 	}
 
 	if q.limit != 0 {
@@ -554,27 +1823,27 @@ func (q *{{ .QueryBuilderStructName }}) sqlSelect() (string, error) {
 func (q *{{ .QueryBuilderStructName }}) sqlUpdate() (string, error) {
 	base := fmt.Sprintf("UPDATE {{.TableName}} ")
 
-	var wheres []string
     var sets []string
 
-    {{ range .Fields }}
-	if q.where.{{.Name}}.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "{{ toSnakeCase .Name }}", q.where.{{ .Name }}.operator, fmt.Sprint(q.where.{{ .Name }}.argument)))
-	}
-	if q.set.{{ .Name }} != "" {
-		sets = append(sets, fmt.Sprintf("%s = %s", "{{ toSnakeCase .Name }}", fmt.Sprint(q.set.{{ .Name }})))
+    {{ range .Fields }}{{ if not .IsReadOnly }}
+	if q.set.{{ .Name }}.isSet {
+		rhs := q.set.{{ .Name }}.literal
+		if rhs == "" {
+			rhs = q.bind(q.set.{{ .Name }}.argument)
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", "{{ .ColumnName }}", rhs))
 	}
-    {{ end }}
+    {{ end }}{{ end }}
 
 	if len(sets) > 0 {
 		base += "SET " + strings.Join(sets, " , ")
 	}
 
-	if len(wheres) > 0 {
-		base += " WHERE " + strings.Join(wheres, " AND ")
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
 	}
-
-
+	base += where
 
 	return base, nil
 }
@@ -582,46 +1851,160 @@ func (q *{{ .QueryBuilderStructName }}) sqlUpdate() (string, error) {
 func (q *{{ .QueryBuilderStructName }}) sqlDelete() (string, error) {
     base := fmt.Sprintf("DELETE FROM {{ .TableName }}")
 
-	var wheres []string
-	{{ range .Fields }}
-	if q.where.{{.Name}}.operator != "" {
-		wheres = append(wheres, fmt.Sprintf("%s %s %s", "{{ toSnakeCase .Name  }}", q.where.{{.Name }}.operator, fmt.Sprint(q.where.{{.Name }}.argument)))
-	}
-	{{ end }}
-	if len(wheres) > 0 {
-		base += " WHERE " + strings.Join(wheres, " AND ")
+	where, err := q.sqlWhere()
+	if err != nil {
+		return "", err
 	}
+	base += where
 
 	return base, nil
 }
 
+// sqlWhere renders the WHERE clause and binds its arguments, it must be called
+// after the arguments of the preceding clauses are bound.
+func (q *{{ .QueryBuilderStructName }}) sqlWhere() (string, error) {
+	where, err := qb.Render(q.where, "AND", q.bind)
+	if err != nil || where == "" {
+		return "", err
+	}
+	return " WHERE " + where, nil
+}
+
+// WhereRaw adds fragment as a predicate as is, it's the escape hatch for the
+// conditions the generated predicates can't express. Never build fragment from
+// user input, pass values through args instead.
+func (q *{{ .QueryBuilderStructName }}) WhereRaw(fragment string, args ...any) {{ .QueryBuilderInterfaceName }} {
+	// parenthesized so an OR in fragment doesn't leak into the other predicates.
+	q.where = append(q.where, qb.Predicate{Raw: "(" + fragment + ")", Arguments: args})
+	return q
+}
+
+// subquery renders a SELECT of column from {{ .TableName }} filtered by the where-clauses
+// of q, the right hand side of the relation predicates of the other builders.
+func (q *{{ .QueryBuilderStructName }}) subquery(column {{ .ModelName }}Column) func(bind func(any) string) (string, error) {
+	return func(bind func(any) string) (string, error) {
+		where, err := qb.Render(q.where, "AND", bind)
+		if err != nil {
+			return "", err
+		}
+		if where != "" {
+			where = " WHERE " + where
+		}
+		return "SELECT " + string(column) + " FROM {{ .TableName }}" + where, nil
+	}
+}
+
+{{ range .Relations }}
+// Where{{ .Name }}Has keeps the {{ $.ModelName }}s {{ if .Many }}with a {{ .Related.ModelName }}{{ else }}whose {{ .Related.ModelName }} is one{{ end }} matching the predicates fn adds.
+func (q *{{ $.QueryBuilderStructName }}) Where{{ .Name }}Has(fn func(b {{ .Related.QueryBuilderInterfaceName }})) {{ $.QueryBuilderInterfaceName }} {
+	related := &{{ .Related.QueryBuilderStructName }}{}
+	fn(related)
+	q.where = append(q.where, qb.Predicate{Column: "{{ .Field.ColumnName }}", Operator: qb.In, Subquery: related.subquery({{ .Related.ModelName }}Columns.{{ .RelatedField.Name }})})
+	return q
+}
+
+{{ if .Target }}
+// Preload{{ .Name }} loads the {{ .Related.ModelName }}{{ if .Many }}s{{ end }} of the rows Fetch, First, Last and FindBy return into their {{ .Target.Name }}
+// with one query, split when the keys exceed the bind parameter limit.
+func (q *{{ $.QueryBuilderStructName }}) Preload{{ .Name }}() {{ $.QueryBuilderInterfaceName }} {
+	q.preload = append(q.preload, q.preload{{ .Name }})
+	return q
+}
+
+func (q *{{ $.QueryBuilderStructName }}) preload{{ .Name }}(ctx context.Context, db qb.Executor, records []{{ $.ModelName }}) error {
+	seen := map[{{ .Field.BaseType }}]bool{}
+	var keys []{{ .Field.BaseType }}
+	for _, record := range records {
+		{{- if .Field.IsNullable }}
+		if {{ .Field.NullCheck (print "record." .Field.Name) }} {
+			continue
+		}
+		{{- end }}
+		if key := {{ .Field.Value (print "record." .Field.Name) }}; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	byKey := map[{{ .Field.BaseType }}]{{ if .Many }}[]{{ else }}*{{ end }}{{ .Related.ModelName }}{}
+	for len(keys) > 0 {
+		n := min(len(keys), {{ bindLimit $.Dialect }})
+		related := &{{ .Related.QueryBuilderStructName }}{}
+		related.where = append(related.where, qb.Predicate{Column: "{{ .RelatedField.ColumnName }}", Operator: qb.In, Arguments: qb.Args(keys[:n])})
+		rows, err := related.fetch(ctx, db)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			{{- if .RelatedField.IsNullable }}
+			if {{ .RelatedField.NullCheck (print "rows[i]." .RelatedField.Name) }} {
+				continue
+			}
+			{{- end }}
+			key := {{ .RelatedField.Value (print "rows[i]." .RelatedField.Name) }}
+			{{ if .Many }}byKey[key] = append(byKey[key], rows[i]){{ else }}byKey[key] = &rows[i]{{ end }}
+		}
+		keys = keys[n:]
+	}
+	for i := range records {
+		{{- if .Field.IsNullable }}
+		if {{ .Field.NullCheck (print "records[i]." .Field.Name) }} {
+			continue
+		}
+		{{- end }}
+		records[i].{{ .Target.Name }} = byKey[{{ .Field.Value (print "records[i]." .Field.Name) }}]
+	}
+	return nil
+}
+{{ end }}
+
+// {{ .Name }}Query returns a query builder of the {{ if .Many }}{{ .Related.ModelName }}s of m{{ else }}{{ .Related.ModelName }} m belongs to{{ end }}.
+func (m {{ $.ModelName }}) {{ .Name }}Query() {{ .Related.QueryBuilderInterfaceName }} {
+	{{- if .Field.IsNullable }}
+	if {{ .Field.NullCheck (print "m." .Field.Name) }} {
+		// a NULL {{ .Field.Name }} references no {{ .Related.ModelName }}, an empty list matches nothing.
+		return {{ .Related.ModelName }}s().Where{{ .RelatedField.Name }}In()
+	}
+	{{- end }}
+	return {{ .Related.ModelName }}s().Where{{ .RelatedField.Name }}Is({{ .RelatedField.Wrap (.Field.Value (print "m." .Field.Name)) }})
+}
+{{ end }}
+
+// Or adds the predicates added by fn as a parenthesized group joined by OR.
+func (q *{{ .QueryBuilderStructName }}) Or(fn func(b {{ .QueryBuilderInterfaceName }})) {{ .QueryBuilderInterfaceName }} {
+	group := &{{ .QueryBuilderStructName }}{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "OR"})
+	return q
+}
+
+// And adds the predicates added by fn as a parenthesized group joined by AND,
+// it's useful to nest conditions inside of Or.
+func (q *{{ .QueryBuilderStructName }}) And(fn func(b {{ .QueryBuilderInterfaceName }})) {{ .QueryBuilderInterfaceName }} {
+	group := &{{ .QueryBuilderStructName }}{}
+	fn(group)
+	q.where = append(q.where, qb.Predicate{Group: group.where, Conjunction: "AND"})
+	return q
+}
+
 {{ range .Fields }}
 {{ if .IsComparable  }}
 func (q *{{ $.QueryBuilderStructName}}) Where{{.Name}}GE({{.Name }} {{.Type}}) {{$.QueryBuilderInterfaceName}} {
-    q.whereArgs = append(q.whereArgs, {{.Name }})
-    q.where.{{.Name }}.argument = q.getPlaceholder()
-    q.where.{{.Name }}.operator = ">="
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.Ge, Argument: {{ .Name }}})
 	return q
 }
 
 func (q *{{ $.QueryBuilderStructName}}) Where{{.Name }}GT({{.Name }} {{.Type}}) {{$.QueryBuilderInterfaceName}} {
-    q.whereArgs = append(q.whereArgs, {{.Name }})
-    q.where.{{.Name }}.argument = q.getPlaceholder()
-    q.where.{{.Name }}.operator = ">"
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.Gt, Argument: {{ .Name }}})
 	return q
 }
 
 func (q *{{ $.QueryBuilderStructName}}) Where{{.Name }}LE({{.Name }} {{.Type}}) {{$.QueryBuilderInterfaceName}} {
-    q.whereArgs = append(q.whereArgs, {{.Name }})
-    q.where.{{.Name }}.argument = q.getPlaceholder()
-    q.where.{{.Name }}.operator = "<="
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.Le, Argument: {{ .Name }}})
 	return q
 }
 
 func (q *{{ $.QueryBuilderStructName}}) Where{{.Name }}LT({{.Name }} {{.Type}}) {{$.QueryBuilderInterfaceName}} {
-    q.whereArgs = append(q.whereArgs, {{.Name }})
-    q.where.{{.Name }}.argument = q.getPlaceholder()
-    q.where.{{.Name }}.operator = "<"
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.Lt, Argument: {{ .Name }}})
 	return q
 }
 
@@ -631,45 +2014,305 @@ func (q *{{ $.QueryBuilderStructName}}) Where{{.Name }}LT({{.Name }} {{.Type}})
 
 
 {{ range .Fields }}
-func (q *{{ $.QueryBuilderStructName}}) Where{{.Name }}(operator string, {{.Name }} {{.Type}}) {{$.QueryBuilderInterfaceName}} {
-    q.whereArgs = append(q.whereArgs, {{.Name }})
-    q.where.{{.Name }}.argument = q.getPlaceholder()
-    q.where.{{.Name }}.operator = operator
+// Where{{.Name}} compares {{ .ColumnName }} using operator, SQL fails for operators not declared by qb.
+func (q *{{ $.QueryBuilderStructName}}) Where{{.Name }}(operator qb.Operator, {{.Name }} {{.Type}}) {{$.QueryBuilderInterfaceName}} {
+	{{- if and .IsString (ne $.Dialect "postgres") }}
+	if operator == qb.ILike {
+		// ILIKE is postgres only, it's emulated as in Where{{ .Name }}ILike.
+		q.where = append(q.where, qb.Predicate{Raw: "LOWER({{ .ColumnName }}) LIKE LOWER(?)", Arguments: []any{ {{- .Name }}}})
+		return q
+	}
+	{{- end }}
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: operator, Argument: {{ .Name }}})
 	return q
 }
 
 func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}Is({{ .Name }} {{ .Type }}) {{ $.QueryBuilderInterfaceName }} {
-    q.whereArgs = append(q.whereArgs, {{.Name}})
-    q.where.{{.Name}}.argument = q.getPlaceholder()
-    q.where.{{.Name}}.operator = "="
+	{{ if .IsNullable }}if {{ .NullCheck .Name }} {
+		return q.Where{{.Name}}IsNull()
+	}
+	{{ end }}
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.Eq, Argument: {{ .Name }}})
+	return q
+}
+
+// Where{{.Name}}In matches rows whose {{ .ColumnName }} is one of {{ .Name }}s, an empty list matches nothing.
+func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}In({{ .Name }}s ...{{ .Type }}) {{ $.QueryBuilderInterfaceName }} {
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.In, Arguments: qb.Args({{ .Name }}s)})
+	return q
+}
+
+// Where{{.Name}}NotIn matches rows whose {{ .ColumnName }} is none of {{ .Name }}s, an empty list matches everything.
+func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}NotIn({{ .Name }}s ...{{ .Type }}) {{ $.QueryBuilderInterfaceName }} {
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.NotIn, Arguments: qb.Args({{ .Name }}s)})
+	return q
+}
+
+{{ if .IsString }}
+// Where{{.Name}}Like matches {{ .ColumnName }} against pattern, % and _ in pattern are wildcards.
+func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}Like(pattern string) {{ $.QueryBuilderInterfaceName }} {
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.Like, Argument: pattern})
+	return q
+}
+
+// Where{{.Name}}ILike matches {{ .ColumnName }} against pattern ignoring case.
+func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}ILike(pattern string) {{ $.QueryBuilderInterfaceName }} {
+	{{ if eq $.Dialect "postgres" -}}
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.ILike, Argument: pattern})
+	{{- else -}}
+    q.where = append(q.where, qb.Predicate{Raw: "LOWER({{ .ColumnName }}) LIKE LOWER(?)", Arguments: []any{pattern}})
+	{{- end }}
+	return q
+}
+
+// Where{{.Name}}StartsWith matches rows whose {{ .ColumnName }} starts with prefix, prefix is matched literally.
+func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}StartsWith(prefix string) {{ $.QueryBuilderInterfaceName }} {
+    q.where = append(q.where, qb.Predicate{Raw: "{{ .ColumnName }} LIKE ? ESCAPE '!'", Arguments: []any{qb.EscapeLike(prefix) + "%"}})
+	return q
+}
+
+// Where{{.Name}}Contains matches rows whose {{ .ColumnName }} contains substring, substring is matched literally.
+func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}Contains(substring string) {{ $.QueryBuilderInterfaceName }} {
+    q.where = append(q.where, qb.Predicate{Raw: "{{ .ColumnName }} LIKE ? ESCAPE '!'", Arguments: []any{"%" + qb.EscapeLike(substring) + "%"}})
 	return q
 }
 {{ end }}
 
-{{ range .Fields }}
+{{ if .IsNullable }}
+func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}IsNull() {{ $.QueryBuilderInterfaceName }} {
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.Is, Literal: "NULL"})
+	return q
+}
+
+func (q *{{ $.QueryBuilderStructName }}) Where{{.Name}}IsNotNull() {{ $.QueryBuilderInterfaceName }} {
+    q.where = append(q.where, qb.Predicate{Column: "{{ .ColumnName }}", Operator: qb.IsNot, Literal: "NULL"})
+	return q
+}
+{{ end }}
+{{ end }}
+
+{{ range .Fields }}{{ if not .IsReadOnly }}
 func (q *{{ $.QueryBuilderStructName }}) Set{{ .Name }}({{ .Name }} {{ .Type }}) {{ $.QueryBuilderInterfaceName }} {
 	q.mode = "update"
-    q.setArgs = append(q.setArgs, {{ .Name }})
-	q.set.{{.Name}} = q.getPlaceholder()
+	q.set.{{.Name}}.argument = {{ .Name }}
+	q.set.{{.Name}}.literal = ""
+	q.set.{{.Name}}.isSet = true
+	return q
+}
+
+{{ if .IsNullable }}
+func (q *{{ $.QueryBuilderStructName }}) Set{{ .Name }}Null() {{ $.QueryBuilderInterfaceName }} {
+	q.mode = "update"
+	q.set.{{.Name}}.literal = "NULL"
+	q.set.{{.Name}}.isSet = true
 	return q
 }
 {{ end }}
+{{ end }}{{ end }}
+
+{{ if .Legacy }}
+func (q *{{ $.QueryBuilderStructName }}) Add(ctx context.Context, record *{{ $.ModelName }}, db qb.Executor) error {
+{{- else }}
+func (q *{{ $.QueryBuilderStructName }}) Add(ctx context.Context, db qb.Executor, record *{{ $.ModelName }}) error {
+{{- end }}
+	query := "INSERT INTO {{ $.TableName }} ({{joinFields .InsertFields}}) VALUES ({{joinPlaceholders (len .InsertFields) .Dialect}}){{ if eq .Dialect "postgres" }}{{ with .AutoIncrementField }} RETURNING {{ .ColumnName }}{{ end }}{{ end }}"
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	{{ if and (eq .Dialect "postgres") .AutoIncrementField }}
+	return db.QueryRowContext(ctx, query, {{ range .InsertFields }}record.{{ .Name }},{{ end }}).Scan(&record.{{ .AutoIncrementField.Name }})
+	{{ else }}
+	{{ if .AutoIncrementField }}res{{ else }}_{{ end }}, err := db.ExecContext(ctx, query, {{ range .InsertFields }}record.{{ .Name }},{{ end }})
+	if err != nil {
+		return err
+	}
+	{{ with .AutoIncrementField }}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	record.{{ .Name }} = {{ .Type }}(id)
+	{{ end }}
+	return nil
+	{{ end }}
+}
+
+{{ if .InsertFields }}
+// AddMany inserts records with multi-row INSERT statements, records are split
+// into chunks so a statement never exceeds the bind parameter limit of the database.{{ with .AutoIncrementField }}
+{{ if eq $.Dialect "postgres" -}}
+// The ids are back-filled from RETURNING assuming it yields the rows in the order
+// of VALUES, which postgres does in practice but doesn't document.
+{{- else if eq $.Dialect "mysql" -}}
+// The ids are back-filled from LastInsertId assuming the rows of a statement get
+// consecutive ids, which innodb_autoinc_lock_mode=2 doesn't guarantee.
+{{- else -}}
+// The ids are back-filled from LastInsertId assuming the rows of a statement get
+// consecutive ids, which SQLite does in practice but doesn't document.
+{{- end }}{{ end }}
+func (q *{{ $.QueryBuilderStructName }}) AddMany(ctx context.Context, db qb.Executor, records []*{{ $.ModelName }}) error {
+	const chunkSize = {{ bindLimit .Dialect }} / {{ len .InsertFields }}
+	for start := 0; start < len(records); start += chunkSize {
+		end := min(start+chunkSize, len(records))
+		if err := q.addMany(ctx, db, records[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-func (q *{{ $.QueryBuilderStructName }}) Add(ctx context.Context, record *{{ $.ModelName }}, db *sql.DB) error {
-	query := "INSERT INTO {{ $.TableName }} ({{joinFields .Fields}}) VALUES ({{joinPlaceholders (len .Fields) "?"}})"	
+func (q *{{ $.QueryBuilderStructName }}) addMany(ctx context.Context, db qb.Executor, records []*{{ $.ModelName }}) error {
+	q.args = nil
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		values = append(values, "(" + strings.Join([]string{ {{ range .InsertFields }}q.bind(record.{{ .Name }}), {{ end }} }, ", ") + ")")
+	}
+	query := "INSERT INTO {{ $.TableName }} ({{joinFields .InsertFields}}) VALUES " + strings.Join(values, ", "){{ if eq .Dialect "postgres" }}{{ with .AutoIncrementField }} + " RETURNING {{ .ColumnName }}"{{ end }}{{ end }}
 	if q.debugMode {
 		fmt.Printf("Generating query: %s\n", query)
 	}
-	res, err := db.ExecContext(ctx, query, {{ range .Fields }}record.{{ .Name }},{{ end }})
+	{{ if and (eq .Dialect "postgres") .AutoIncrementField }}
+	rows, err := db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	// assumes the ids come back in the order of the VALUES rows, see AddMany.
+	n := 0
+	for ; rows.Next(); n++ {
+		if n < len(records) {
+			if err := rows.Scan(&records[n].{{ .AutoIncrementField.Name }}); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if n != len(records) {
+		return fmt.Errorf("insert of %d {{ $.ModelName }}s returned %d ids", len(records), n)
+	}
+	return nil
+	{{ else }}
+	{{ if .AutoIncrementField }}res{{ else }}_{{ end }}, err := db.ExecContext(ctx, query, q.args...)
 	if err != nil {
-		return err 
+		return err
+	}
+	{{ with .AutoIncrementField }}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	{{ if eq $.Dialect "sqlite" }}// SQLite reports the id of the last row, rows of a single statement get consecutive ids.
+	id -= int64(len(records) - 1)
+	{{ else }}// MySQL reports the id of the first row, rows of a single statement get consecutive ids.
+	{{ end }}for i, record := range records {
+		record.{{ .Name }} = {{ .Type }}(id + int64(i))
+	}
+	{{ end }}
+	return nil
+	{{ end }}
+}
+
+// OnConflictUpdate sets the columns Upsert updates when the row already exists,
+// the rest of the columns keep their current values.
+func (q *{{ $.QueryBuilderStructName }}) OnConflictUpdate(columns ...{{ $.ModelName }}Column) {{ $.QueryBuilderInterfaceName }} {
+	// a non nil empty slice keeps every column as is.
+	q.onConflictUpdate = append([]{{ $.ModelName }}Column{}, columns...)
+	return q
+}
+
+// Upsert inserts record, if a row with the same conflictColumns already exists
+// it updates the columns set by OnConflictUpdate instead, by default every inserted
+// column that is not part of conflictColumns.{{ if eq .Dialect "mysql" }} MySQL picks the conflicting row by the
+// unique keys of the table, conflictColumns only excludes columns from the update.{{ else }} conflictColumns defaults to the
+// primary key unless the database fills it in.{{ end }}
+func (q *{{ $.QueryBuilderStructName }}) Upsert(ctx context.Context, db qb.Executor, record *{{ $.ModelName }}, conflictColumns ...{{ $.ModelName }}Column) error {
+	if len(conflictColumns) == 0 {
+		conflictColumns = []{{ $.ModelName }}Column{ {{ range .ConflictTarget }}{{ $.ModelName }}Columns.{{ .Name }}, {{ end }} }
+	}
+
+	updates := q.onConflictUpdate
+	if updates == nil {
+	columns:
+		for _, column := range []{{ $.ModelName }}Column{ {{ range .InsertFields }}{{ $.ModelName }}Columns.{{ .Name }}, {{ end }} } {
+			for _, conflictColumn := range conflictColumns {
+				if column == conflictColumn {
+					continue columns
+				}
+			}
+			updates = append(updates, column)
+		}
 	}
 
+	q.args = nil
+	query := "INSERT INTO {{ $.TableName }} ({{joinFields .InsertFields}}) VALUES (" + strings.Join([]string{ {{ range .InsertFields }}q.bind(record.{{ .Name }}), {{ end }} }, ", ") + ")"
+
+	var sets []string
+	{{ if eq .Dialect "mysql" }}
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", column, column))
+	}
+	{{ with .AutoIncrementField }}// makes LastInsertId report the id of the updated row.
+	sets = append(sets, "{{ .ColumnName }} = LAST_INSERT_ID({{ .ColumnName }})")
+	{{ end }}
+	if len(sets) == 0 {
+		sets = append(sets, "{{ (index .InsertFields 0).ColumnName }} = {{ (index .InsertFields 0).ColumnName }}")
+	}
+	query += " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	{{ else }}
+	for _, column := range updates {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	var target []string
+	for _, column := range conflictColumns {
+		target = append(target, string(column))
+	}
+	if len(target) == 0 && len(sets) > 0 {
+		{{- if .PrimaryKeys }}
+		return fmt.Errorf("upsert of {{ $.ModelName }} needs conflictColumns to update the conflicting row, the primary key of {{ $.ModelName }} is not inserted")
+		{{- else }}
+		return fmt.Errorf("upsert of {{ $.ModelName }} needs conflictColumns to update the conflicting row, {{ $.ModelName }} has no primary key")
+		{{- end }}
+	}
+	query += " ON CONFLICT"
+	if len(target) > 0 {
+		query += " (" + strings.Join(target, ", ") + ")"
+	}
+	if len(sets) == 0 {
+		query += " DO NOTHING"
+	} else {
+		query += " DO UPDATE SET " + strings.Join(sets, ", ")
+	}
+	{{ end }}
+	{{- if and (ne .Dialect "mysql") .AutoIncrementField }}
+	query += " RETURNING {{ .AutoIncrementField.ColumnName }}"
+	{{- end }}
+	if q.debugMode {
+		fmt.Printf("Generating query: %s\n", query)
+	}
+	{{ if and (ne .Dialect "mysql") .AutoIncrementField }}
+	err := db.QueryRowContext(ctx, query, q.args...).Scan(&record.{{ .AutoIncrementField.Name }})
+	if err == sql.ErrNoRows {
+		// DO NOTHING doesn't return the existing row.
+		return nil
+	}
+	return err
+	{{ else if and (eq .Dialect "mysql") .AutoIncrementField }}
+	res, err := db.ExecContext(ctx, query, q.args...)
+	if err != nil {
+		return err
+	}
 	id, err := res.LastInsertId()
 	if err != nil {
 		return err
 	}
-	record.ID = id
+	record.{{ .AutoIncrementField.Name }} = {{ .AutoIncrementField.Type }}(id)
 	return nil
-}`,
+	{{ else }}
+	_, err := db.ExecContext(ctx, query, q.args...)
+	return err
+	{{ end }}
+}
+{{ end }}`,
 ))
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGoldenModelsUpToDate makes sure the generated code the golden tests run
+// against is the output of the current generator.
+func TestGoldenModelsUpToDate(t *testing.T) {
+	tests := []struct {
+		dialect string
+		legacy  bool
+		dir     string
+		files   []string
+	}{
+		{"postgres", false, "internal/golden/postgres", []string{"model.go", "post.go", "account.go", "article.go", "keys.go"}},
+		{"mysql", false, "internal/golden/mysql", []string{"model.go"}},
+		{"sqlite", false, "internal/golden/sqlite", []string{"model.go"}},
+		{"postgres", true, "internal/golden/legacy", []string{"model.go"}},
+	}
+	for _, tt := range tests {
+		t.Run(filepath.Base(tt.dir), func(t *testing.T) {
+			tmp := t.TempDir()
+			for _, file := range tt.files {
+				model, err := os.ReadFile(filepath.Join(tt.dir, file))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(tmp, file), model, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for _, file := range tt.files {
+				generateForFile(tt.dialect, tt.legacy, filepath.Join(tmp, file))
+
+				gen := strings.TrimSuffix(file, ".go") + "_model_gen.go"
+				got, err := os.ReadFile(filepath.Join(tmp, gen))
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, err := os.ReadFile(filepath.Join(tt.dir, gen))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != string(want) {
+					t.Errorf("%s/%s is stale, run go generate ./...", tt.dir, gen)
+				}
+			}
+		})
+	}
+}
+
+func TestUnresolvedRelation(t *testing.T) {
+	tmp := t.TempDir()
+	model := []byte("package models\n\n// @querybuilder\ntype Post struct {\n\tID     int64\n\tUserID int64 `qb:\"belongs_to=User\"`\n}\n")
+	if err := os.WriteFile(filepath.Join(tmp, "post.go"), model, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for a relation to a model that doesn't exist")
+		}
+	}()
+	generateForFile("postgres", false, filepath.Join(tmp, "post.go"))
+}
+
+func TestRelationErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		models string
+	}{
+		{"duplicate relation names", "type Account struct {\n\tID int64 `qb:\"pk\"`\n}\n\n// @querybuilder\ntype Transfer struct {\n\tID      int64 `qb:\"pk\"`\n\tFromID  int64 `qb:\"belongs_to=Account\"`\n\tFrom    int64 `qb:\"belongs_to=Account\"`\n}\n"},
+		{"ambiguous has_many", "type Account struct {\n\tID   int64 `qb:\"pk\"`\n\tSent []Transfer `qb:\"has_many\"`\n}\n\n// @querybuilder\ntype Transfer struct {\n\tID     int64 `qb:\"pk\"`\n\tFromID int64 `qb:\"belongs_to=Account\"`\n\tToID   int64 `qb:\"belongs_to=Account\"`\n}\n"},
+		{"has_many through an unknown field", "type Account struct {\n\tID   int64 `qb:\"pk\"`\n\tSent []Transfer `qb:\"has_many=SenderID\"`\n}\n\n// @querybuilder\ntype Transfer struct {\n\tID     int64 `qb:\"pk\"`\n\tFromID int64 `qb:\"belongs_to=Account\"`\n}\n"},
+		{"ambiguous belongs_to target", "type Account struct {\n\tID int64 `qb:\"pk\"`\n}\n\n// @querybuilder\ntype Transfer struct {\n\tID      int64 `qb:\"pk\"`\n\tFromID  int64 `qb:\"belongs_to=Account\"`\n\tToID    int64 `qb:\"belongs_to=Account\"`\n\tAccount *Account `qb:\"belongs_to\"`\n}\n"},
+		{"nullable foreign key of another type", "type Account struct {\n\tID int64 `qb:\"pk\"`\n}\n\n// @querybuilder\ntype Transfer struct {\n\tID     int64 `qb:\"pk\"`\n\tFromID *int32 `qb:\"belongs_to=Account\"`\n}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmp := t.TempDir()
+			model := []byte("package models\n\n// @querybuilder\n" + tt.models)
+			if err := os.WriteFile(filepath.Join(tmp, "models.go"), model, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if recover() == nil {
+					t.Fatal("expected a panic")
+				}
+			}()
+			generateForFile("postgres", false, filepath.Join(tmp, "models.go"))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package qb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// SortKey is a column of a keyset, the ordering rows are paginated in.
+type SortKey struct {
+	Column string
+	Desc   bool
+}
+
+// EncodeCursor encodes the values of the sort keys of a row, by column, into an
+// opaque token.
+func EncodeCursor(values map[string]any) (string, error) {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeCursor decodes a token made by EncodeCursor into targets, a pointer per
+// column. Every column of targets must be in the cursor.
+func DecodeCursor(cursor string, targets map[string]any) error {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(b, &values); err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+	for column, target := range targets {
+		value, ok := values[column]
+		if !ok {
+			return fmt.Errorf("invalid cursor: no value for column %s", column)
+		}
+		if err := json.Unmarshal(value, target); err != nil {
+			return fmt.Errorf("invalid cursor: column %s: %w", column, err)
+		}
+	}
+	return nil
+}
+
+// Keyset matches the rows that come after values in the order of keys, or before
+// them if backward is set, eg: for a ASC, b DESC it's
+// (a > ?) OR (a = ? AND b < ?). The columns of keys must not be NULL.
+func Keyset(keys []SortKey, values []any, backward bool) Predicate {
+	keyset := Predicate{Conjunction: "OR"}
+	for i, key := range keys {
+		operator := Gt
+		if key.Desc != backward {
+			operator = Lt
+		}
+		branch := Predicate{Conjunction: "AND"}
+		for j := range keys[:i] {
+			branch.Group = append(branch.Group, Predicate{Column: keys[j].Column, Operator: Eq, Argument: values[j]})
+		}
+		branch.Group = append(branch.Group, Predicate{Column: key.Column, Operator: operator, Argument: values[i]})
+		keyset.Group = append(keyset.Group, branch)
+	}
+	return keyset
+}
@@ -0,0 +1,52 @@
+package qb
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestKeyset(t *testing.T) {
+	keys := []SortKey{{Column: "name"}, {Column: "age", Desc: true}, {Column: "id"}}
+	values := []any{"john", 30, 7}
+	tests := []struct {
+		backward bool
+		want     string
+		args     string
+	}{
+		{false, "((name > $1) OR (name = $2 AND age < $3) OR (name = $4 AND age = $5 AND id > $6))", "[john john 30 john 30 7]"},
+		{true, "((name < $1) OR (name = $2 AND age > $3) OR (name = $4 AND age = $5 AND id < $6))", "[john john 30 john 30 7]"},
+	}
+	for _, tt := range tests {
+		bind, args := numbered()
+		got, err := Render([]Predicate{Keyset(keys, values, tt.backward)}, "AND", bind)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want || fmt.Sprint(*args) != tt.args {
+			t.Errorf("Keyset(backward=%v) = %q %v, want %q %s", tt.backward, got, *args, tt.want, tt.args)
+		}
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor, err := EncodeCursor(map[string]any{"name": "john", "id": int64(1) << 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var name string
+	var id int64
+	if err := DecodeCursor(cursor, map[string]any{"name": &name, "id": &id}); err != nil {
+		t.Fatal(err)
+	}
+	if name != "john" || id != int64(1)<<60 {
+		t.Fatalf("decoded name %q and id %d", name, id)
+	}
+
+	var age int
+	if err := DecodeCursor(cursor, map[string]any{"age": &age}); err == nil {
+		t.Fatal("expected an error for a column the cursor doesn't have")
+	}
+	if err := DecodeCursor("not a cursor", map[string]any{"id": &id}); err == nil {
+		t.Fatal("expected an error for a malformed cursor")
+	}
+}
@@ -0,0 +1,21 @@
+// Package qb holds the runtime pieces shared by the code generated by querybuilder.
+package qb
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Executor runs queries, it's implemented by *sql.DB, *sql.Tx and *sql.Conn so the
+// same generated query builder works inside and outside of transactions.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+var (
+	_ Executor = (*sql.DB)(nil)
+	_ Executor = (*sql.Tx)(nil)
+	_ Executor = (*sql.Conn)(nil)
+)
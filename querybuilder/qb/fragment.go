@@ -0,0 +1,24 @@
+package qb
+
+import "strings"
+
+// Fragment is a piece of SQL, each ? in SQL is bound to the next element of Args
+// as in RenderRaw.
+type Fragment struct {
+	SQL  string
+	Args []any
+}
+
+// RenderFragments renders fragments separated by commas, binding their
+// arguments in order.
+func RenderFragments(fragments []Fragment, bind func(any) string) (string, error) {
+	parts := make([]string, len(fragments))
+	for i, fragment := range fragments {
+		part, err := RenderRaw(fragment.SQL, fragment.Args, bind)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, ", "), nil
+}
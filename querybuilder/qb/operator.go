@@ -0,0 +1,31 @@
+package qb
+
+// Operator is the comparison operator of a predicate. Only the operators declared
+// here are accepted, rendering a predicate with any other operator fails so an
+// operator can never be used to inject SQL.
+type Operator string
+
+const (
+	Eq      Operator = "="
+	Ne      Operator = "<>"
+	Gt      Operator = ">"
+	Ge      Operator = ">="
+	Lt      Operator = "<"
+	Le      Operator = "<="
+	Like    Operator = "LIKE"
+	NotLike Operator = "NOT LIKE"
+	ILike   Operator = "ILIKE" // postgres only, the other dialects rewrite it to LOWER(column) LIKE LOWER(?)
+	In      Operator = "IN"
+	NotIn   Operator = "NOT IN"
+	Is      Operator = "IS"
+	IsNot   Operator = "IS NOT"
+)
+
+// Valid reports whether o is one of the declared operators.
+func (o Operator) Valid() bool {
+	switch o {
+	case Eq, Ne, Gt, Ge, Lt, Le, Like, NotLike, ILike, In, NotIn, Is, IsNot:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package qb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Predicate is a single condition of a WHERE clause or a parenthesized group of
+// conditions.
+type Predicate struct {
+	Column   string
+	Operator Operator
+	Argument any
+	// Literal is rendered instead of binding Argument, eg: NULL.
+	Literal string
+	// Arguments holds the list of In and NotIn predicates and the arguments of Raw.
+	Arguments []any
+
+	// Raw is an SQL fragment rendered as is, each ? in it is bound to the next
+	// element of Arguments. Use ?? for a literal question mark.
+	Raw string
+
+	// Subquery renders the right hand side of an In or NotIn predicate in place
+	// of Arguments, eg: a SELECT from a related table, binding its arguments with bind.
+	Subquery func(bind func(any) string) (string, error)
+
+	// Group holds nested predicates joined by Conjunction, either AND or OR.
+	// A predicate with a Conjunction is a group, even an empty one.
+	Group       []Predicate
+	Conjunction string
+}
+
+// Render renders predicates joined by conjunction. bind is called for every
+// argument in the order they appear in the output and returns its placeholder.
+func Render(predicates []Predicate, conjunction string, bind func(any) string) (string, error) {
+	parts := make([]string, 0, len(predicates))
+	for _, p := range predicates {
+		part, err := p.render(bind)
+		if err != nil {
+			return "", err
+		}
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " "+conjunction+" "), nil
+}
+
+func (p Predicate) render(bind func(any) string) (string, error) {
+	if p.Conjunction != "" {
+		group, err := Render(p.Group, p.Conjunction, bind)
+		if err != nil || group == "" {
+			return "", err
+		}
+		return "(" + group + ")", nil
+	}
+
+	if p.Raw != "" {
+		return RenderRaw(p.Raw, p.Arguments, bind)
+	}
+
+	if !p.Operator.Valid() {
+		return "", fmt.Errorf("unsupported operator %q on column %s", p.Operator, p.Column)
+	}
+
+	if p.Operator == In || p.Operator == NotIn {
+		if p.Subquery != nil {
+			subquery, err := p.Subquery(bind)
+			if err != nil {
+				return "", err
+			}
+			return p.Column + " " + string(p.Operator) + " (" + subquery + ")", nil
+		}
+		args := p.Arguments
+		if args == nil {
+			args = []any{p.Argument}
+		}
+		if len(args) == 0 {
+			// an empty list matches nothing, IN () is not valid SQL.
+			if p.Operator == In {
+				return "1 = 0", nil
+			}
+			return "1 = 1", nil
+		}
+		placeholders := make([]string, len(args))
+		for i, argument := range args {
+			placeholders[i] = bind(argument)
+		}
+		return p.Column + " " + string(p.Operator) + " (" + strings.Join(placeholders, ", ") + ")", nil
+	}
+
+	rhs := p.Literal
+	if rhs == "" {
+		rhs = bind(p.Argument)
+	}
+	return p.Column + " " + string(p.Operator) + " " + rhs, nil
+}
+
+// RenderRaw binds each ? of fragment to the next argument and replaces it with
+// the placeholder returned by bind. Question marks inside quoted strings are
+// left alone and ?? renders a literal question mark, eg: the JSON operators of
+// postgres.
+func RenderRaw(fragment string, args []any, bind func(any) string) (string, error) {
+	var out strings.Builder
+	var quote rune
+	next := 0
+	runes := []rune(fragment)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '?' && i+1 < len(runes) && runes[i+1] == '?':
+			i++
+		case r == '?':
+			if next == len(args) {
+				return "", fmt.Errorf("raw fragment %q has more placeholders than the %d arguments", fragment, len(args))
+			}
+			out.WriteString(bind(args[next]))
+			next++
+			continue
+		}
+		out.WriteRune(r)
+	}
+	if next != len(args) {
+		return "", fmt.Errorf("raw fragment %q has %d placeholders but %d arguments", fragment, next, len(args))
+	}
+	return out.String(), nil
+}
+
+// LikeEscape is the escape character of the patterns built by EscapeLike.
+const LikeEscape = '!'
+
+// EscapeLike escapes the wildcards of a LIKE pattern, the predicate must declare
+// LikeEscape as the escape character with ESCAPE '!'.
+func EscapeLike(s string) string {
+	var out strings.Builder
+	for _, r := range s {
+		if r == '%' || r == '_' || r == LikeEscape {
+			out.WriteRune(LikeEscape)
+		}
+		out.WriteRune(r)
+	}
+	return out.String()
+}
+
+// Args converts values to a slice of arguments.
+func Args[T any](values []T) []any {
+	args := make([]any, len(values))
+	for i, value := range values {
+		args[i] = value
+	}
+	return args
+}
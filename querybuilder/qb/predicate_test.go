@@ -0,0 +1,57 @@
+package qb
+
+import (
+	"fmt"
+	"testing"
+)
+
+func numbered() (func(any) string, *[]any) {
+	var args []any
+	return func(arg any) string {
+		args = append(args, arg)
+		return fmt.Sprintf("$%d", len(args))
+	}, &args
+}
+
+func TestRenderRaw(t *testing.T) {
+	tests := []struct {
+		fragment string
+		args     []any
+		want     string
+		wantErr  bool
+	}{
+		{"date(created_at) = ?", []any{"2024-01-01"}, "date(created_at) = $1", false},
+		{"a = ? AND b IN (?, ?)", []any{1, 2, 3}, "a = $1 AND b IN ($2, $3)", false},
+		{"data ?? 'key' AND name = ?", []any{"x"}, "data ? 'key' AND name = $1", false},
+		{"name = '?' AND id = ?", []any{1}, "name = '?' AND id = $1", false},
+		{"a = ?", nil, "", true},
+		{"a = 1", []any{1}, "", true},
+	}
+	for _, tt := range tests {
+		bind, _ := numbered()
+		got, err := RenderRaw(tt.fragment, tt.args, bind)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("RenderRaw(%q) error = %v, wantErr %v", tt.fragment, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("RenderRaw(%q) = %q, want %q", tt.fragment, got, tt.want)
+		}
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	tests := map[string]string{
+		"plain":   "plain",
+		"50%":     "50!%",
+		"a_b":     "a!_b",
+		"wow!":    "wow!!",
+		"!%_":     "!!!%!_",
+		"ünicode": "ünicode",
+	}
+	for in, want := range tests {
+		if got := EscapeLike(in); got != want {
+			t.Errorf("EscapeLike(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package qb
+
+import "database/sql"
+
+// Collect scans every row into a T, targets returns the fields of t the columns
+// are scanned into in order. rows is closed before Collect returns.
+//
+//	rows, err := Users().SelectRaw("name, COUNT(*)").GroupBy(UserColumns.Name).Rows(ctx, db)
+//	...
+//	counts, err := qb.Collect(rows, func(c *NameCount) []any { return []any{&c.Name, &c.Count} })
+func Collect[T any](rows *sql.Rows, targets func(t *T) []any) ([]T, error) {
+	defer rows.Close()
+	var records []T
+	for rows.Next() {
+		var t T
+		if err := rows.Scan(targets(&t)...); err != nil {
+			return nil, err
+		}
+		records = append(records, t)
+	}
+	return records, rows.Err()
+}
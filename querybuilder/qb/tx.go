@@ -0,0 +1,132 @@
+package qb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Beginner starts transactions, it's implemented by *sql.DB and *sql.Conn.
+type Beginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// TxOptions configures WithTx.
+type TxOptions struct {
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+
+	// MaxRetries is the number of times the transaction is run again when
+	// Retryable reports the error as transient.
+	MaxRetries int
+	// Backoff is the wait before the first retry, it grows linearly on each attempt.
+	Backoff time.Duration
+	// Retryable classifies errors, see RetryableFor for the dialect defaults.
+	Retryable func(error) bool
+}
+
+// WithTx runs fn inside a transaction. The transaction is committed when fn
+// returns nil and rolled back when it returns an error or panics, a panic is
+// returned as an error. When opts allows it, serialization failures and deadlocks
+// are retried with a fresh transaction.
+func WithTx(ctx context.Context, db Beginner, opts *TxOptions, fn func(tx Executor) error) error {
+	if opts == nil {
+		opts = &TxOptions{}
+	}
+	for attempt := 0; ; attempt++ {
+		err := runTx(ctx, db, opts, fn)
+		if err == nil || attempt >= opts.MaxRetries || opts.Retryable == nil || !opts.Retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(opts.Backoff * time.Duration(attempt+1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func runTx(ctx context.Context, db Beginner, opts *TxOptions, fn func(tx Executor) error) (err error) {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+	if err != nil {
+		return err
+	}
+
+	committing := false
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic in transaction: %v", p)
+		}
+		// a failed Commit has already ended the transaction, rolling it back
+		// would only add sql.ErrTxDone to the commit error.
+		if err != nil && !committing {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				err = errors.Join(err, rollbackErr)
+			}
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+	committing = true
+	return tx.Commit()
+}
+
+// RetryableFor returns the error classifier of the given dialect, one of mysql,
+// postgres and sqlite. It returns nil for unknown dialects.
+func RetryableFor(dialect string) func(error) bool {
+	switch dialect {
+	case "mysql":
+		return MySQLRetryable
+	case "postgres":
+		return PostgresRetryable
+	case "sqlite":
+		return SQLiteRetryable
+	}
+	return nil
+}
+
+type sqlStateError interface {
+	SQLState() string
+}
+
+type codeError interface {
+	Code() int
+}
+
+// MySQLRetryable reports deadlocks (error 1213).
+func MySQLRetryable(err error) bool {
+	return hasErrorMessage(err, "Error 1213")
+}
+
+// PostgresRetryable reports serialization failures (SQLSTATE 40001) and deadlocks (SQLSTATE 40P01).
+func PostgresRetryable(err error) bool {
+	var stateErr sqlStateError
+	if errors.As(err, &stateErr) {
+		state := stateErr.SQLState()
+		return state == "40001" || state == "40P01"
+	}
+	return hasErrorMessage(err, "SQLSTATE 40001") || hasErrorMessage(err, "SQLSTATE 40P01")
+}
+
+// SQLiteRetryable reports SQLITE_BUSY errors.
+func SQLiteRetryable(err error) bool {
+	const sqliteBusy = 5
+	var codeErr codeError
+	if errors.As(err, &codeErr) {
+		// extended result codes keep the primary code in the lower 8 bits.
+		return codeErr.Code()&0xff == sqliteBusy
+	}
+	return hasErrorMessage(err, "database is locked") || hasErrorMessage(err, "SQLITE_BUSY")
+}
+
+func hasErrorMessage(err error, message string) bool {
+	return err != nil && strings.Contains(err.Error(), message)
+}
@@ -0,0 +1,185 @@
+package qb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type pgError struct{ code string }
+
+func (e pgError) Error() string    { return "pg error " + e.code }
+func (e pgError) SQLState() string { return e.code }
+
+type sqliteError struct{ code int }
+
+func (e sqliteError) Error() string { return fmt.Sprintf("sqlite error %d", e.code) }
+func (e sqliteError) Code() int     { return e.code }
+
+func TestRetryableFor(t *testing.T) {
+	tests := []struct {
+		dialect string
+		err     error
+		want    bool
+	}{
+		{"mysql", errors.New("Error 1213 (40001): Deadlock found when trying to get lock"), true},
+		{"mysql", errors.New("Error 1062 (23000): Duplicate entry"), false},
+		{"postgres", fmt.Errorf("update: %w", pgError{"40001"}), true},
+		{"postgres", pgError{"40P01"}, true},
+		{"postgres", pgError{"23505"}, false},
+		{"sqlite", sqliteError{5}, true},
+		{"sqlite", sqliteError{5 | 2<<8}, true},
+		{"sqlite", errors.New("database is locked"), true},
+		{"sqlite", sqliteError{19}, false},
+		{"sqlite", nil, false},
+	}
+	for _, tt := range tests {
+		if got := RetryableFor(tt.dialect)(tt.err); got != tt.want {
+			t.Errorf("RetryableFor(%q)(%v) = %v, want %v", tt.dialect, tt.err, got, tt.want)
+		}
+	}
+}
+
+// fakeDB is a database/sql driver whose transactions only count how they end.
+type fakeDB struct {
+	begins    atomic.Int32
+	commits   atomic.Int32
+	rollbacks atomic.Int32
+	commitErr error
+}
+
+func (d *fakeDB) Connect(context.Context) (driver.Conn, error) { return fakeConn{d}, nil }
+func (d *fakeDB) Driver() driver.Driver                        { return d }
+func (d *fakeDB) Open(string) (driver.Conn, error)             { return fakeConn{d}, nil }
+
+type fakeConn struct{ d *fakeDB }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) {
+	c.d.begins.Add(1)
+	return fakeTx{c.d}, nil
+}
+
+type fakeTx struct{ d *fakeDB }
+
+func (tx fakeTx) Commit() error {
+	tx.d.commits.Add(1)
+	return tx.d.commitErr
+}
+
+func (tx fakeTx) Rollback() error {
+	tx.d.rollbacks.Add(1)
+	return nil
+}
+
+func openFake(t *testing.T) (*sql.DB, *fakeDB) {
+	d := &fakeDB{}
+	db := sql.OpenDB(d)
+	t.Cleanup(func() { db.Close() })
+	return db, d
+}
+
+func (d *fakeDB) check(t *testing.T, begins, commits, rollbacks int32) {
+	t.Helper()
+	if d.begins.Load() != begins || d.commits.Load() != commits || d.rollbacks.Load() != rollbacks {
+		t.Errorf("got %d begins, %d commits and %d rollbacks, want %d, %d and %d",
+			d.begins.Load(), d.commits.Load(), d.rollbacks.Load(), begins, commits, rollbacks)
+	}
+}
+
+func TestWithTxCommits(t *testing.T) {
+	db, d := openFake(t)
+	if err := WithTx(context.Background(), db, nil, func(Executor) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	d.check(t, 1, 1, 0)
+}
+
+func TestWithTxRollsBackOnError(t *testing.T) {
+	db, d := openFake(t)
+	failed := errors.New("failed")
+	if err := WithTx(context.Background(), db, nil, func(Executor) error { return failed }); err != failed {
+		t.Fatalf("got error %v, want %v", err, failed)
+	}
+	d.check(t, 1, 0, 1)
+}
+
+func TestWithTxRollsBackOnPanic(t *testing.T) {
+	db, d := openFake(t)
+	err := WithTx(context.Background(), db, nil, func(Executor) error { panic("boom") })
+	if err == nil || !strings.Contains(err.Error(), "panic in transaction: boom") {
+		t.Fatalf("got error %v, want the panic", err)
+	}
+	d.check(t, 1, 0, 1)
+}
+
+func TestWithTxCommitError(t *testing.T) {
+	db, d := openFake(t)
+	d.commitErr = pgError{"40001"}
+	err := WithTx(context.Background(), db, nil, func(Executor) error { return nil })
+	if err != d.commitErr {
+		t.Fatalf("got error %v, want only the commit error", err)
+	}
+	d.check(t, 1, 1, 0)
+}
+
+func TestWithTxRetries(t *testing.T) {
+	db, d := openFake(t)
+	opts := &TxOptions{MaxRetries: 2, Retryable: PostgresRetryable}
+	attempts := 0
+	err := WithTx(context.Background(), db, opts, func(Executor) error {
+		attempts++
+		return pgError{"40001"}
+	})
+	if err == nil || attempts != 3 {
+		t.Fatalf("got error %v after %d attempts, want an error after 3", err, attempts)
+	}
+	d.check(t, 3, 0, 3)
+}
+
+func TestWithTxRetriesCommitError(t *testing.T) {
+	db, d := openFake(t)
+	d.commitErr = pgError{"40001"}
+	opts := &TxOptions{MaxRetries: 1, Retryable: PostgresRetryable}
+	if err := WithTx(context.Background(), db, opts, func(Executor) error { return nil }); err != d.commitErr {
+		t.Fatalf("got error %v, want %v", err, d.commitErr)
+	}
+	d.check(t, 2, 2, 0)
+}
+
+func TestWithTxDoesNotRetryPermanentErrors(t *testing.T) {
+	db, d := openFake(t)
+	opts := &TxOptions{MaxRetries: 5, Retryable: PostgresRetryable}
+	attempts := 0
+	err := WithTx(context.Background(), db, opts, func(Executor) error {
+		attempts++
+		return pgError{"23505"}
+	})
+	if err == nil || attempts != 1 {
+		t.Fatalf("got error %v after %d attempts, want an error after 1", err, attempts)
+	}
+	d.check(t, 1, 0, 1)
+}
+
+func TestWithTxStopsWhenCanceledDuringBackoff(t *testing.T) {
+	db, _ := openFake(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := &TxOptions{MaxRetries: 5, Backoff: time.Hour, Retryable: PostgresRetryable}
+	attempts := 0
+	err := WithTx(ctx, db, opts, func(Executor) error {
+		attempts++
+		time.AfterFunc(10*time.Millisecond, cancel)
+		return pgError{"40001"}
+	})
+	if !errors.Is(err, context.Canceled) || !PostgresRetryable(err) || attempts != 1 {
+		t.Fatalf("got error %v after %d attempts, want the serialization failure and context.Canceled after 1", err, attempts)
+	}
+}